@@ -41,15 +41,7 @@ resource "securecn_environment" "env1" {
   }
 }
 
-resource "time_sleep" "wait_for_first_status_sync" {
-  depends_on = [securecn_k8s_cluster.terraform_cluster]
-
-  create_duration = "30s"
-}
-
 resource "securecn_deployer" "vault" {
-  depends_on = [time_sleep.wait_for_first_status_sync]
-
   name = "vault"
   operator_deployer {
     cluster_id      = securecn_k8s_cluster.terraform_cluster.id
@@ -64,6 +56,18 @@ resource "securecn_ci_policy" "vault" {
   vulnerability_policy {
     permissible_vulnerability_level = "MEDIUM"
     enforcement_option              = "FAIL"
+    fail_only_if_fix_available      = true
+
+    allowlist {
+      vulnerability   = "CVE-2021-44228"
+      package_name    = "log4j-core"
+      expiration_date = "2030-01-01"
+    }
+
+    grace_period {
+      severity = "HIGH"
+      days     = 14
+    }
   }
 }
 
@@ -92,6 +96,24 @@ resource "securecn_connection_rule" "securecn_connection_rule" {
   }
 }
 
+resource "securecn_pod_security_policy_profile" "restricted" {
+  name                       = "terraform-restricted"
+  required_drop_capabilities = ["ALL"]
+  volumes                    = ["configMap", "emptyDir", "projected", "secret", "persistentVolumeClaim"]
+
+  run_as_user {
+    rule = "MustRunAsNonRoot"
+  }
+
+  fs_group {
+    rule = "MustRunAs"
+    range {
+      min = 1
+      max = 65535
+    }
+  }
+}
+
 resource "securecn_deployment_rule" "rule1" {
   rule_name = "terraform deployment rule"
 
@@ -99,7 +121,7 @@ resource "securecn_deployment_rule" "rule1" {
     names                             = ["Finance"]
     vulnerability_severity_level      = "HIGH"
     vulnerability_on_violation_action = "BLOCK"
-    psp_profile                       = "Baseline"
+    psp_profile                       = securecn_pod_security_policy_profile.restricted.name
     psp_on_violation_action           = "ENFORCE"
   }
 
@@ -0,0 +1,229 @@
+package escherClient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"terraform-provider-securecn/internal/escher_api/model"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+)
+
+const idPathParam = "id"
+
+// APIError is an error response of the management API, with its status code and the message of the server
+type APIError struct {
+	Operation  string
+	StatusCode int
+	Message    string
+}
+
+func (apiError *APIError) Error() string {
+	if apiError.Message == "" {
+		return fmt.Sprintf("failed to %s: status %d", apiError.Operation, apiError.StatusCode)
+	}
+
+	return fmt.Sprintf("failed to %s: status %d: %s", apiError.Operation, apiError.StatusCode, apiError.Message)
+}
+
+// IsNotFound returns true if the error is a not found response of the management API
+func IsNotFound(err error) bool {
+	var apiError *APIError
+	return errors.As(err, &apiError) && apiError.StatusCode == http.StatusNotFound
+}
+
+// consumeFunc reads a successful response body into out
+type consumeFunc func(body io.Reader, consumer runtime.Consumer, out interface{}) error
+
+// apiOperation is a call of the management API. The body is sent as JSON and a successful response
+// is read into out, unless out is nil
+type apiOperation struct {
+	name        string
+	method      string
+	pathPattern string
+	pathParams  map[string]string
+	queryParams map[string]string
+	accept      string
+	body        interface{}
+	out         interface{}
+	consume     consumeFunc
+}
+
+// call submits the operation, error responses are returned as an *APIError
+func (serviceMgmtApi *MgmtServiceApiCtx) call(ctx context.Context, client *http.Client, operation apiOperation) error {
+	log.Printf("[DEBUG] %s", operation.name)
+
+	accept := operation.accept
+	if accept == "" {
+		accept = runtime.JSONMime
+	}
+
+	_, err := serviceMgmtApi.runtime.Submit(&runtime.ClientOperation{
+		ID:                 operation.name,
+		Method:             operation.method,
+		PathPattern:        operation.pathPattern,
+		ProducesMediaTypes: []string{accept},
+		ConsumesMediaTypes: []string{runtime.JSONMime},
+		Schemes:            []string{"https"},
+		AuthInfo:           serviceMgmtApi.auth,
+		Params:             runtime.ClientRequestWriterFunc(operation.writeRequest),
+		Reader:             runtime.ClientResponseReaderFunc(operation.readResponse),
+		Context:            ctx,
+		Client:             client,
+	})
+
+	return err
+}
+
+func (operation apiOperation) writeRequest(request runtime.ClientRequest, _ strfmt.Registry) error {
+	for name, value := range operation.pathParams {
+		if err := request.SetPathParam(name, value); err != nil {
+			return err
+		}
+	}
+
+	for name, value := range operation.queryParams {
+		if value == "" {
+			continue
+		}
+		if err := request.SetQueryParam(name, value); err != nil {
+			return err
+		}
+	}
+
+	if operation.body != nil {
+		return request.SetBodyParam(operation.body)
+	}
+
+	return nil
+}
+
+func (operation apiOperation) readResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	if response.Code()/100 != 2 {
+		return nil, newAPIError(operation.name, response)
+	}
+
+	if operation.out == nil {
+		return nil, nil
+	}
+
+	consume := operation.consume
+	if consume == nil {
+		consume = consumeResponse
+	}
+
+	if err := consume(response.Body(), consumer, operation.out); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("failed to read the response to %s: %v", operation.name, err)
+	}
+
+	return nil, nil
+}
+
+// consumeResponse copies the response to out if it is a writer, and decodes it into out otherwise
+func consumeResponse(body io.Reader, consumer runtime.Consumer, out interface{}) error {
+	if writer, ok := out.(io.Writer); ok {
+		_, err := io.Copy(writer, body)
+		return err
+	}
+
+	return consumer.Consume(body, out)
+}
+
+// newAPIError reads the message of the server from an error response, it is either a JSON object
+// with a message or plain text
+func newAPIError(operation string, response runtime.ClientResponse) *APIError {
+	apiError := &APIError{Operation: operation, StatusCode: response.Code()}
+
+	body, err := ioutil.ReadAll(response.Body())
+	if err != nil {
+		return apiError
+	}
+
+	var payload model.APIResponse
+	if json.Unmarshal(body, &payload) == nil {
+		apiError.Message = payload.Message
+	} else {
+		apiError.Message = strings.TrimSpace(string(body))
+	}
+
+	return apiError
+}
+
+// ResourceClient lists, gets, creates, updates and deletes the objects of a collection of the management API.
+// The objects are read into the out argument, a pointer to the model of the collection
+type ResourceClient struct {
+	api            *MgmtServiceApiCtx
+	kind           string
+	collectionPath string
+	objectPath     string
+	consume        consumeFunc
+}
+
+func (resourceClient ResourceClient) List(ctx context.Context, client *http.Client, out interface{}) error {
+	return resourceClient.api.call(ctx, client, apiOperation{
+		name:        "list " + resourceClient.kind,
+		method:      http.MethodGet,
+		pathPattern: resourceClient.collectionPath,
+		out:         out,
+		consume:     resourceClient.consume,
+	})
+}
+
+func (resourceClient ResourceClient) Get(ctx context.Context, client *http.Client, id strfmt.UUID, out interface{}) error {
+	return resourceClient.api.call(ctx, client, apiOperation{
+		name:        fmt.Sprintf("get %s %s", resourceClient.kind, id),
+		method:      http.MethodGet,
+		pathPattern: resourceClient.objectPath,
+		pathParams:  map[string]string{idPathParam: id.String()},
+		out:         out,
+		consume:     resourceClient.consume,
+	})
+}
+
+func (resourceClient ResourceClient) Create(ctx context.Context, client *http.Client, object interface{}, out interface{}) error {
+	return resourceClient.api.call(ctx, client, apiOperation{
+		name:        "create " + resourceClient.kind,
+		method:      http.MethodPost,
+		pathPattern: resourceClient.collectionPath,
+		body:        object,
+		out:         out,
+		consume:     resourceClient.consume,
+	})
+}
+
+func (resourceClient ResourceClient) Update(ctx context.Context, client *http.Client, id strfmt.UUID, object interface{}, out interface{}) error {
+	return resourceClient.api.call(ctx, client, apiOperation{
+		name:        fmt.Sprintf("update %s %s", resourceClient.kind, id),
+		method:      http.MethodPut,
+		pathPattern: resourceClient.objectPath,
+		pathParams:  map[string]string{idPathParam: id.String()},
+		body:        object,
+		out:         out,
+		consume:     resourceClient.consume,
+	})
+}
+
+func (resourceClient ResourceClient) Delete(ctx context.Context, client *http.Client, id strfmt.UUID) error {
+	return resourceClient.api.call(ctx, client, apiOperation{
+		name:        fmt.Sprintf("delete %s %s", resourceClient.kind, id),
+		method:      http.MethodDelete,
+		pathPattern: resourceClient.objectPath,
+		pathParams:  map[string]string{idPathParam: id.String()},
+	})
+}
+
+func (serviceMgmtApi *MgmtServiceApiCtx) resourceClient(kind string, collectionPath string, objectPath string) ResourceClient {
+	return ResourceClient{
+		api:            serviceMgmtApi,
+		kind:           kind,
+		collectionPath: collectionPath,
+		objectPath:     objectPath,
+	}
+}
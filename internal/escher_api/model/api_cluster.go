@@ -125,6 +125,10 @@ type KubernetesCluster struct {
 	// controller status
 	ControllerStatus ControllerStatus `json:"controllerStatus,omitempty"`
 
+	// the version of the controller currently installed on the cluster
+	// Read Only: true
+	ControllerVersion string `json:"controllerVersion,omitempty"`
+
 	AutoUpgradeControllerVersion *bool `json:"autoUpgradeControllerVersion,omitempty"`
 }
 
@@ -0,0 +1,8 @@
+package model
+
+// APIResponse is the body of an error response of the management API
+type APIResponse struct {
+
+	// message
+	Message string `json:"message,omitempty"`
+}
@@ -41,6 +41,9 @@ type CdServerlessRule struct {
 
 	ruleField ServerlessRuleType
 
+	// rule origin
+	RuleOrigin *ServerlessRuleOrigin `json:"ruleOrigin,omitempty"`
+
 	// scope
 	Scope []*ServerlessRuleScope `json:"scope"`
 
@@ -108,6 +111,9 @@ func (m *CdServerlessRule) UnmarshalJSON(raw []byte) error {
 	// rule
 	result.ruleField = propRule
 
+	// ruleOrigin
+	result.RuleOrigin = data.RuleOrigin
+
 	// scope
 	result.Scope = data.Scope
 
@@ -147,6 +153,8 @@ func (m CdServerlessRule) MarshalJSON() ([]byte, error) {
 
 		Name: m.Name,
 
+		RuleOrigin: m.RuleOrigin,
+
 		Scope: m.Scope,
 
 		Status: m.Status,
@@ -187,6 +195,10 @@ func (m *CdServerlessRule) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateRuleOrigin(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateScope(formats); err != nil {
 		res = append(res, err)
 	}
@@ -260,6 +272,23 @@ func (m *CdServerlessRule) validateRule(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *CdServerlessRule) validateRuleOrigin(formats strfmt.Registry) error {
+	if swag.IsZero(m.RuleOrigin) { // not required
+		return nil
+	}
+
+	if m.RuleOrigin != nil {
+		if err := m.RuleOrigin.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("ruleOrigin")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *CdServerlessRule) validateScope(formats strfmt.Registry) error {
 	if swag.IsZero(m.Scope) { // not required
 		return nil
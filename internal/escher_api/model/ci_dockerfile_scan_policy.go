@@ -6,6 +6,8 @@ package model
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"strconv"
+
 	strfmt "github.com/go-openapi/strfmt"
 
 	"github.com/go-openapi/errors"
@@ -26,6 +28,9 @@ type CiDockerfileScanPolicy struct {
 	// permissible dockerfile scan severity
 	// Required: true
 	PermissibleDockerfileScanSeverity DockerfileScanSeverity `json:"permissibleDockerfileScanSeverity"`
+
+	// rule exceptions
+	RuleExceptions []*CiDockerfileScanRuleException `json:"ruleExceptions"`
 }
 
 // Validate validates this ci dockerfile scan policy
@@ -40,6 +45,10 @@ func (m *CiDockerfileScanPolicy) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateRuleExceptions(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -70,6 +79,31 @@ func (m *CiDockerfileScanPolicy) validatePermissibleDockerfileScanSeverity(forma
 	return nil
 }
 
+func (m *CiDockerfileScanPolicy) validateRuleExceptions(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.RuleExceptions) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.RuleExceptions); i++ {
+		if swag.IsZero(m.RuleExceptions[i]) { // not required
+			continue
+		}
+
+		if m.RuleExceptions[i] != nil {
+			if err := m.RuleExceptions[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("ruleExceptions" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *CiDockerfileScanPolicy) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -0,0 +1,116 @@
+package model
+
+import (
+	"encoding/json"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// CiDockerfileScanRuleException a dockerfile scan rule that is ignored or always fails the policy, optionally only for some images
+// swagger:model CiDockerfileScanRuleException
+type CiDockerfileScanRuleException struct {
+
+	// action
+	// Required: true
+	// Enum: [IGNORE FAIL]
+	Action string `json:"action"`
+
+	// the images the exception applies to, as a glob pattern of the image name, all images when empty
+	ImageNamePattern string `json:"imageNamePattern,omitempty"`
+
+	// the dockerfile scan rule id (e.g. CIS-DI-0009)
+	// Required: true
+	RuleID string `json:"ruleId"`
+}
+
+var ciDockerfileScanRuleExceptionTypeActionPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["IGNORE","FAIL"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		ciDockerfileScanRuleExceptionTypeActionPropEnum = append(ciDockerfileScanRuleExceptionTypeActionPropEnum, v)
+	}
+}
+
+const (
+
+	// CiDockerfileScanRuleExceptionActionIGNORE captures enum value "IGNORE"
+	CiDockerfileScanRuleExceptionActionIGNORE string = "IGNORE"
+
+	// CiDockerfileScanRuleExceptionActionFAIL captures enum value "FAIL"
+	CiDockerfileScanRuleExceptionActionFAIL string = "FAIL"
+)
+
+// prop value enum
+func (m *CiDockerfileScanRuleException) validateActionEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, ciDockerfileScanRuleExceptionTypeActionPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this ci dockerfile scan rule exception
+func (m *CiDockerfileScanRuleException) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAction(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRuleID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CiDockerfileScanRuleException) validateAction(formats strfmt.Registry) error {
+
+	if err := validate.RequiredString("action", "body", string(m.Action)); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateActionEnum("action", "body", m.Action); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CiDockerfileScanRuleException) validateRuleID(formats strfmt.Registry) error {
+
+	if err := validate.RequiredString("ruleId", "body", string(m.RuleID)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CiDockerfileScanRuleException) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CiDockerfileScanRuleException) UnmarshalBinary(b []byte) error {
+	var res CiDockerfileScanRuleException
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,69 @@
+package model
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// CiVulnerabilityAllowlistEntry a vulnerability, a package or a vulnerability of a package that doesn't fail the policy until it expires
+// swagger:model CiVulnerabilityAllowlistEntry
+type CiVulnerabilityAllowlistEntry struct {
+
+	// expiration date
+	// Format: date
+	ExpirationDate *strfmt.Date `json:"expirationDate,omitempty"`
+
+	// package name
+	PackageName string `json:"packageName,omitempty"`
+
+	// vulnerability name
+	VulnerabilityName string `json:"vulnerabilityName,omitempty"`
+}
+
+// Validate validates this ci vulnerability allowlist entry
+func (m *CiVulnerabilityAllowlistEntry) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateExpirationDate(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CiVulnerabilityAllowlistEntry) validateExpirationDate(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ExpirationDate) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("expirationDate", "body", "date", m.ExpirationDate.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CiVulnerabilityAllowlistEntry) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CiVulnerabilityAllowlistEntry) UnmarshalBinary(b []byte) error {
+	var res CiVulnerabilityAllowlistEntry
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,67 @@
+package model
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// CiVulnerabilityGracePeriod the number of days since a vulnerability of a severity was published during which it doesn't fail the policy
+// swagger:model CiVulnerabilityGracePeriod
+type CiVulnerabilityGracePeriod struct {
+
+	// days
+	Days int64 `json:"days,omitempty"`
+
+	// severity
+	Severity VulnerabilitySeverity `json:"severity,omitempty"`
+}
+
+// Validate validates this ci vulnerability grace period
+func (m *CiVulnerabilityGracePeriod) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateSeverity(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CiVulnerabilityGracePeriod) validateSeverity(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Severity) { // not required
+		return nil
+	}
+
+	if err := m.Severity.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("severity")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CiVulnerabilityGracePeriod) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CiVulnerabilityGracePeriod) UnmarshalBinary(b []byte) error {
+	var res CiVulnerabilityGracePeriod
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
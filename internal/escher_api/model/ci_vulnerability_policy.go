@@ -6,6 +6,8 @@ package model
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"strconv"
+
 	strfmt "github.com/go-openapi/strfmt"
 
 	"github.com/go-openapi/errors"
@@ -20,10 +22,19 @@ type CiVulnerabilityPolicy struct {
 	// Required: true
 	EnforcementOption EnforcementOption `json:"enforcementOption"`
 
+	// fail only when a fix is available for the vulnerability
+	FailOnlyIfFixAvailable bool `json:"failOnlyIfFixAvailable,omitempty"`
+
+	// grace periods by the age of the vulnerabilities
+	GracePeriods []*CiVulnerabilityGracePeriod `json:"gracePeriods"`
+
 	// permissible vulnerability level
 	// Required: true
 	PermissibleVulnerabilityLevel VulnerabilitySeverity `json:"permissibleVulnerabilityLevel"`
 
+	// vulnerability allowlist
+	VulnerabilityAllowlist []*CiVulnerabilityAllowlistEntry `json:"vulnerabilityAllowlist"`
+
 	// a list of vulnerability names to ignore
 	VulnerabilitiesToIgnore []string `json:"vulnerabilitiesToIgnore,omitempty"`
 }
@@ -36,10 +47,18 @@ func (m *CiVulnerabilityPolicy) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateGracePeriods(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validatePermissibleVulnerabilityLevel(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateVulnerabilityAllowlist(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -58,6 +77,31 @@ func (m *CiVulnerabilityPolicy) validateEnforcementOption(formats strfmt.Registr
 	return nil
 }
 
+func (m *CiVulnerabilityPolicy) validateGracePeriods(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.GracePeriods) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.GracePeriods); i++ {
+		if swag.IsZero(m.GracePeriods[i]) { // not required
+			continue
+		}
+
+		if m.GracePeriods[i] != nil {
+			if err := m.GracePeriods[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("gracePeriods" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *CiVulnerabilityPolicy) validatePermissibleVulnerabilityLevel(formats strfmt.Registry) error {
 
 	if err := m.PermissibleVulnerabilityLevel.Validate(formats); err != nil {
@@ -70,6 +114,31 @@ func (m *CiVulnerabilityPolicy) validatePermissibleVulnerabilityLevel(formats st
 	return nil
 }
 
+func (m *CiVulnerabilityPolicy) validateVulnerabilityAllowlist(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.VulnerabilityAllowlist) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.VulnerabilityAllowlist); i++ {
+		if swag.IsZero(m.VulnerabilityAllowlist[i]) { // not required
+			continue
+		}
+
+		if m.VulnerabilityAllowlist[i] != nil {
+			if err := m.VulnerabilityAllowlist[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("vulnerabilityAllowlist" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *CiVulnerabilityPolicy) MarshalBinary() ([]byte, error) {
 	if m == nil {
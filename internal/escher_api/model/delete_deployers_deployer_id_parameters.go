@@ -1,133 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package model
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-
-	strfmt "github.com/go-openapi/strfmt"
-)
-
-// NewDeleteDeployersDeployerIDParams creates a new DeleteDeployersDeployerIDParams object
-// with the default values initialized.
-func NewDeleteDeployersDeployerIDParams() *DeleteDeployersDeployerIDParams {
-	var ()
-	return &DeleteDeployersDeployerIDParams{
-
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewDeleteDeployersDeployerIDParamsWithTimeout creates a new DeleteDeployersDeployerIDParams object
-// with the default values initialized, and the ability to set a timeout on a request
-func NewDeleteDeployersDeployerIDParamsWithTimeout(timeout time.Duration) *DeleteDeployersDeployerIDParams {
-	var ()
-	return &DeleteDeployersDeployerIDParams{
-
-		timeout: timeout,
-	}
-}
-
-// NewDeleteDeployersDeployerIDParamsWithContext creates a new DeleteDeployersDeployerIDParams object
-// with the default values initialized, and the ability to set a context for a request
-func NewDeleteDeployersDeployerIDParamsWithContext(ctx context.Context) *DeleteDeployersDeployerIDParams {
-	var ()
-	return &DeleteDeployersDeployerIDParams{
-
-		Context: ctx,
-	}
-}
-
-// NewDeleteDeployersDeployerIDParamsWithHTTPClient creates a new DeleteDeployersDeployerIDParams object
-// with the default values initialized, and the ability to set a custom HTTPClient for a request
-func NewDeleteDeployersDeployerIDParamsWithHTTPClient(client *http.Client) *DeleteDeployersDeployerIDParams {
-	var ()
-	return &DeleteDeployersDeployerIDParams{
-		HTTPClient: client,
-	}
-}
-
-/*DeleteDeployersDeployerIDParams contains all the parameters to send to the API endpoint
-for the delete deployers deployer ID operation typically these are written to a http.Request
-*/
-type DeleteDeployersDeployerIDParams struct {
-
-	/*DeployerID*/
-	DeployerID strfmt.UUID
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithTimeout adds the timeout to the delete deployers deployer ID params
-func (o *DeleteDeployersDeployerIDParams) WithTimeout(timeout time.Duration) *DeleteDeployersDeployerIDParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the delete deployers deployer ID params
-func (o *DeleteDeployersDeployerIDParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the delete deployers deployer ID params
-func (o *DeleteDeployersDeployerIDParams) WithContext(ctx context.Context) *DeleteDeployersDeployerIDParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the delete deployers deployer ID params
-func (o *DeleteDeployersDeployerIDParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the delete deployers deployer ID params
-func (o *DeleteDeployersDeployerIDParams) WithHTTPClient(client *http.Client) *DeleteDeployersDeployerIDParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the delete deployers deployer ID params
-func (o *DeleteDeployersDeployerIDParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithDeployerID adds the deployerID to the delete deployers deployer ID params
-func (o *DeleteDeployersDeployerIDParams) WithDeployerID(deployerID strfmt.UUID) *DeleteDeployersDeployerIDParams {
-	o.SetDeployerID(deployerID)
-	return o
-}
-
-// SetDeployerID adds the deployerId to the delete deployers deployer ID params
-func (o *DeleteDeployersDeployerIDParams) SetDeployerID(deployerID strfmt.UUID) {
-	o.DeployerID = deployerID
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *DeleteDeployersDeployerIDParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-
-	// path param deployerId
-	if err := r.SetPathParam("deployerId", o.DeployerID.String()); err != nil {
-		return err
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
@@ -1,133 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package model
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-
-	strfmt "github.com/go-openapi/strfmt"
-)
-
-// NewGetCdPodSecurityPolicyProfilesPodSecurityPolicyProfileNameParams creates a new GetCdPodSecurityPolicyProfilesPodSecurityPolicyProfileNameParams object
-// with the default values initialized.
-func NewGetCdPodSecurityPolicyProfilesPodSecurityPolicyProfileNameParams() *GetCdPodSecurityPolicyProfilesPodSecurityPolicyProfileNameParams {
-	var ()
-	return &GetCdPodSecurityPolicyProfilesPodSecurityPolicyProfileNameParams{
-
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewGetCdPodSecurityPolicyProfilesPodSecurityPolicyProfileNameParamsWithTimeout creates a new GetCdPodSecurityPolicyProfilesPodSecurityPolicyProfileNameParams object
-// with the default values initialized, and the ability to set a timeout on a request
-func NewGetCdPodSecurityPolicyProfilesPodSecurityPolicyProfileNameParamsWithTimeout(timeout time.Duration) *GetCdPodSecurityPolicyProfilesPodSecurityPolicyProfileNameParams {
-	var ()
-	return &GetCdPodSecurityPolicyProfilesPodSecurityPolicyProfileNameParams{
-
-		timeout: timeout,
-	}
-}
-
-// NewGetCdPodSecurityPolicyProfilesPodSecurityPolicyProfileNameParamsWithContext creates a new GetCdPodSecurityPolicyProfilesPodSecurityPolicyProfileNameParams object
-// with the default values initialized, and the ability to set a context for a request
-func NewGetCdPodSecurityPolicyProfilesPodSecurityPolicyProfileNameParamsWithContext(ctx context.Context) *GetCdPodSecurityPolicyProfilesPodSecurityPolicyProfileNameParams {
-	var ()
-	return &GetCdPodSecurityPolicyProfilesPodSecurityPolicyProfileNameParams{
-
-		Context: ctx,
-	}
-}
-
-// NewGetCdPodSecurityPolicyProfilesPodSecurityPolicyProfileNameParamsWithHTTPClient creates a new GetCdPodSecurityPolicyProfilesPodSecurityPolicyProfileNameParams object
-// with the default values initialized, and the ability to set a custom HTTPClient for a request
-func NewGetCdPodSecurityPolicyProfilesPodSecurityPolicyProfileNameParamsWithHTTPClient(client *http.Client) *GetCdPodSecurityPolicyProfilesPodSecurityPolicyProfileNameParams {
-	var ()
-	return &GetCdPodSecurityPolicyProfilesPodSecurityPolicyProfileNameParams{
-		HTTPClient: client,
-	}
-}
-
-/*GetCdPodSecurityPolicyProfilesPodSecurityPolicyProfileNameParams contains all the parameters to send to the API endpoint
-for the get cd pod security policy profiles pod security policy profile name operation typically these are written to a http.Request
-*/
-type GetCdPodSecurityPolicyProfilesPodSecurityPolicyProfileNameParams struct {
-
-	/*PodSecurityPolicyProfileName*/
-	PodSecurityPolicyProfileName string
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithTimeout adds the timeout to the get cd pod security policy profiles pod security policy profile name params
-func (o *GetCdPodSecurityPolicyProfilesPodSecurityPolicyProfileNameParams) WithTimeout(timeout time.Duration) *GetCdPodSecurityPolicyProfilesPodSecurityPolicyProfileNameParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the get cd pod security policy profiles pod security policy profile name params
-func (o *GetCdPodSecurityPolicyProfilesPodSecurityPolicyProfileNameParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the get cd pod security policy profiles pod security policy profile name params
-func (o *GetCdPodSecurityPolicyProfilesPodSecurityPolicyProfileNameParams) WithContext(ctx context.Context) *GetCdPodSecurityPolicyProfilesPodSecurityPolicyProfileNameParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the get cd pod security policy profiles pod security policy profile name params
-func (o *GetCdPodSecurityPolicyProfilesPodSecurityPolicyProfileNameParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the get cd pod security policy profiles pod security policy profile name params
-func (o *GetCdPodSecurityPolicyProfilesPodSecurityPolicyProfileNameParams) WithHTTPClient(client *http.Client) *GetCdPodSecurityPolicyProfilesPodSecurityPolicyProfileNameParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the get cd pod security policy profiles pod security policy profile name params
-func (o *GetCdPodSecurityPolicyProfilesPodSecurityPolicyProfileNameParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithPodSecurityPolicyProfileName adds the podSecurityPolicyProfileName to the get cd pod security policy profiles pod security policy profile name params
-func (o *GetCdPodSecurityPolicyProfilesPodSecurityPolicyProfileNameParams) WithPodSecurityPolicyProfileName(podSecurityPolicyProfileName string) *GetCdPodSecurityPolicyProfilesPodSecurityPolicyProfileNameParams {
-	o.SetPodSecurityPolicyProfileName(podSecurityPolicyProfileName)
-	return o
-}
-
-// SetPodSecurityPolicyProfileName adds the podSecurityPolicyProfileName to the get cd pod security policy profiles pod security policy profile name params
-func (o *GetCdPodSecurityPolicyProfilesPodSecurityPolicyProfileNameParams) SetPodSecurityPolicyProfileName(podSecurityPolicyProfileName string) {
-	o.PodSecurityPolicyProfileName = podSecurityPolicyProfileName
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *GetCdPodSecurityPolicyProfilesPodSecurityPolicyProfileNameParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-
-	// path param podSecurityPolicyProfileName
-	if err := r.SetPathParam("podSecurityPolicyProfileName", o.PodSecurityPolicyProfileName); err != nil {
-		return err
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
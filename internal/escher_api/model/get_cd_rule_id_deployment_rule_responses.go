@@ -1,68 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package model
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-
-	strfmt "github.com/go-openapi/strfmt"
-)
-
-// GetCdRuleIDDeploymentRuleReader is a Reader for the GetCdRuleIDDeploymentRule structure.
-type GetCdRuleIDDeploymentRuleReader struct {
-	Formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *GetCdRuleIDDeploymentRuleReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewGetCdRuleIDDeploymentRuleOK()
-		if err := result.readResponse(response, consumer, o.Formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-
-	default:
-		return nil, runtime.NewAPIError("unknown error", response, response.Code())
-	}
-}
-
-// NewGetCdRuleIDDeploymentRuleOK creates a GetCdRuleIDDeploymentRuleOK with default headers values
-func NewGetCdRuleIDDeploymentRuleOK() *GetCdRuleIDDeploymentRuleOK {
-	return &GetCdRuleIDDeploymentRuleOK{}
-}
-
-/*GetCdRuleIDDeploymentRuleOK handles this case with default header values.
-
-OK
-*/
-type GetCdRuleIDDeploymentRuleOK struct {
-	Payload *CdAppRule
-}
-
-func (o *GetCdRuleIDDeploymentRuleOK) Error() string {
-	return fmt.Sprintf("[GET /cd/{ruleId}/deploymentRule][%d] getCdRuleIdDeploymentRuleOK  %+v", 200, o.Payload)
-}
-
-func (o *GetCdRuleIDDeploymentRuleOK) GetPayload() *CdAppRule {
-	return o.Payload
-}
-
-func (o *GetCdRuleIDDeploymentRuleOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(CdAppRule)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
@@ -1,66 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package model
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-
-)
-
-// GetCdRuleIDServerlessRuleReader is a Reader for the GetCdRuleIDServerlessRule structure.
-type GetCdRuleIDServerlessRuleReader struct {
-	Formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *GetCdRuleIDServerlessRuleReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewGetCdRuleIDServerlessRuleOK()
-		if err := result.readResponse(response, consumer, o.Formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	default:
-		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
-	}
-}
-
-// NewGetCdRuleIDServerlessRuleOK creates a GetCdRuleIDServerlessRuleOK with default headers values
-func NewGetCdRuleIDServerlessRuleOK() *GetCdRuleIDServerlessRuleOK {
-	return &GetCdRuleIDServerlessRuleOK{}
-}
-
-/* GetCdRuleIDServerlessRuleOK describes a response with status code 200, with default header values.
-
-OK
-*/
-type GetCdRuleIDServerlessRuleOK struct {
-	Payload *CdServerlessRule
-}
-
-func (o *GetCdRuleIDServerlessRuleOK) Error() string {
-	return fmt.Sprintf("[GET /cd/{ruleId}/serverlessRule][%d] getCdRuleIdServerlessRuleOK  %+v", 200, o.Payload)
-}
-func (o *GetCdRuleIDServerlessRuleOK) GetPayload() *CdServerlessRule {
-	return o.Payload
-}
-
-func (o *GetCdRuleIDServerlessRuleOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(CdServerlessRule)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
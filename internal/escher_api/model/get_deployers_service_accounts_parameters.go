@@ -1,169 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package model
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-
-	strfmt "github.com/go-openapi/strfmt"
-)
-
-// NewGetDeployersServiceAccountsParams creates a new GetDeployersServiceAccountsParams object
-// with the default values initialized.
-func NewGetDeployersServiceAccountsParams() *GetDeployersServiceAccountsParams {
-	var ()
-	return &GetDeployersServiceAccountsParams{
-
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewGetDeployersServiceAccountsParamsWithTimeout creates a new GetDeployersServiceAccountsParams object
-// with the default values initialized, and the ability to set a timeout on a request
-func NewGetDeployersServiceAccountsParamsWithTimeout(timeout time.Duration) *GetDeployersServiceAccountsParams {
-	var ()
-	return &GetDeployersServiceAccountsParams{
-
-		timeout: timeout,
-	}
-}
-
-// NewGetDeployersServiceAccountsParamsWithContext creates a new GetDeployersServiceAccountsParams object
-// with the default values initialized, and the ability to set a context for a request
-func NewGetDeployersServiceAccountsParamsWithContext(ctx context.Context) *GetDeployersServiceAccountsParams {
-	var ()
-	return &GetDeployersServiceAccountsParams{
-
-		Context: ctx,
-	}
-}
-
-// NewGetDeployersServiceAccountsParamsWithHTTPClient creates a new GetDeployersServiceAccountsParams object
-// with the default values initialized, and the ability to set a custom HTTPClient for a request
-func NewGetDeployersServiceAccountsParamsWithHTTPClient(client *http.Client) *GetDeployersServiceAccountsParams {
-	var ()
-	return &GetDeployersServiceAccountsParams{
-		HTTPClient: client,
-	}
-}
-
-/*GetDeployersServiceAccountsParams contains all the parameters to send to the API endpoint
-for the get deployers service accounts operation typically these are written to a http.Request
-*/
-type GetDeployersServiceAccountsParams struct {
-
-	/*KubernetesClusterID*/
-	KubernetesClusterID strfmt.UUID
-	/*NamespaceName
-	  the namespace name to filter by
-
-	*/
-	NamespaceName *string
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithTimeout adds the timeout to the get deployers service accounts params
-func (o *GetDeployersServiceAccountsParams) WithTimeout(timeout time.Duration) *GetDeployersServiceAccountsParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the get deployers service accounts params
-func (o *GetDeployersServiceAccountsParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the get deployers service accounts params
-func (o *GetDeployersServiceAccountsParams) WithContext(ctx context.Context) *GetDeployersServiceAccountsParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the get deployers service accounts params
-func (o *GetDeployersServiceAccountsParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the get deployers service accounts params
-func (o *GetDeployersServiceAccountsParams) WithHTTPClient(client *http.Client) *GetDeployersServiceAccountsParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the get deployers service accounts params
-func (o *GetDeployersServiceAccountsParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithKubernetesClusterID adds the kubernetesClusterID to the get deployers service accounts params
-func (o *GetDeployersServiceAccountsParams) WithKubernetesClusterID(kubernetesClusterID strfmt.UUID) *GetDeployersServiceAccountsParams {
-	o.SetKubernetesClusterID(kubernetesClusterID)
-	return o
-}
-
-// SetKubernetesClusterID adds the kubernetesClusterId to the get deployers service accounts params
-func (o *GetDeployersServiceAccountsParams) SetKubernetesClusterID(kubernetesClusterID strfmt.UUID) {
-	o.KubernetesClusterID = kubernetesClusterID
-}
-
-// WithNamespaceName adds the namespaceName to the get deployers service accounts params
-func (o *GetDeployersServiceAccountsParams) WithNamespaceName(namespaceName *string) *GetDeployersServiceAccountsParams {
-	o.SetNamespaceName(namespaceName)
-	return o
-}
-
-// SetNamespaceName adds the namespaceName to the get deployers service accounts params
-func (o *GetDeployersServiceAccountsParams) SetNamespaceName(namespaceName *string) {
-	o.NamespaceName = namespaceName
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *GetDeployersServiceAccountsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-
-	// query param kubernetesClusterId
-	qrKubernetesClusterID := o.KubernetesClusterID
-	qKubernetesClusterID := qrKubernetesClusterID.String()
-	if qKubernetesClusterID != "" {
-		if err := r.SetQueryParam("kubernetesClusterId", qKubernetesClusterID); err != nil {
-			return err
-		}
-	}
-
-	if o.NamespaceName != nil {
-
-		// query param namespaceName
-		var qrNamespaceName string
-		if o.NamespaceName != nil {
-			qrNamespaceName = *o.NamespaceName
-		}
-		qNamespaceName := qrNamespaceName
-		if qNamespaceName != "" {
-			if err := r.SetQueryParam("namespaceName", qNamespaceName); err != nil {
-				return err
-			}
-		}
-
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
@@ -1,68 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package model
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-
-	strfmt "github.com/go-openapi/strfmt"
-)
-
-// GetEnvironmentsEnvIDReader is a Reader for the GetEnvironmentsEnvID structure.
-type GetEnvironmentsEnvIDReader struct {
-	Formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *GetEnvironmentsEnvIDReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewGetEnvironmentsEnvIDOK()
-		if err := result.readResponse(response, consumer, o.Formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-
-	default:
-		return nil, runtime.NewAPIError("unknown error", response, response.Code())
-	}
-}
-
-// NewGetEnvironmentsEnvIDOK creates a GetEnvironmentsEnvIDOK with default headers values
-func NewGetEnvironmentsEnvIDOK() *GetEnvironmentsEnvIDOK {
-	return &GetEnvironmentsEnvIDOK{}
-}
-
-/*GetEnvironmentsEnvIDOK handles this case with default header values.
-
-OK
-*/
-type GetEnvironmentsEnvIDOK struct {
-	Payload *Environment
-}
-
-func (o *GetEnvironmentsEnvIDOK) Error() string {
-	return fmt.Sprintf("[GET /environments/{envId}][%d] getEnvironmentsEnvIdOK  %+v", 200, o.Payload)
-}
-
-func (o *GetEnvironmentsEnvIDOK) GetPayload() *Environment {
-	return o.Payload
-}
-
-func (o *GetEnvironmentsEnvIDOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(Environment)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
@@ -34,6 +34,9 @@ type KubernetesEnvironment struct {
 	// namespace labels
 	NamespaceLabels []*Label `json:"namespaceLabels"`
 
+	// namespace label expressions, matched together with the namespace labels
+	NamespaceLabelExpressions []*LabelSelectorRequirement `json:"namespaceLabelExpressions,omitempty"`
+
 	// namespaces
 	Namespaces []string `json:"namespaces"`
 
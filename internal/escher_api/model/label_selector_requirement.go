@@ -0,0 +1,59 @@
+package model
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+const (
+
+	// LabelSelectorOperatorIn captures enum value "In"
+	LabelSelectorOperatorIn string = "In"
+
+	// LabelSelectorOperatorNotIn captures enum value "NotIn"
+	LabelSelectorOperatorNotIn string = "NotIn"
+
+	// LabelSelectorOperatorExists captures enum value "Exists"
+	LabelSelectorOperatorExists string = "Exists"
+
+	// LabelSelectorOperatorDoesNotExist captures enum value "DoesNotExist"
+	LabelSelectorOperatorDoesNotExist string = "DoesNotExist"
+)
+
+// LabelSelectorRequirement label selector requirement
+// swagger:model LabelSelectorRequirement
+type LabelSelectorRequirement struct {
+
+	// key
+	Key string `json:"key,omitempty"`
+
+	// operator
+	// Enum: [In NotIn Exists DoesNotExist]
+	Operator string `json:"operator,omitempty"`
+
+	// values
+	Values []string `json:"values"`
+}
+
+// Validate validates this label selector requirement
+func (m *LabelSelectorRequirement) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *LabelSelectorRequirement) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *LabelSelectorRequirement) UnmarshalBinary(b []byte) error {
+	var res LabelSelectorRequirement
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,135 @@
+package model
+
+import (
+	"encoding/json"
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PodSecurityPolicyIDRangeStrategy the rule and ranges a user or group id of a pod must follow
+// swagger:model PodSecurityPolicyIDRangeStrategy
+type PodSecurityPolicyIDRangeStrategy struct {
+
+	// ranges
+	Ranges []*PodSecurityPolicyRange `json:"ranges"`
+
+	// rule
+	// Required: true
+	// Enum: [MustRunAs MayRunAs MustRunAsNonRoot RunAsAny]
+	Rule string `json:"rule"`
+}
+
+var podSecurityPolicyIdRangeStrategyTypeRulePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["MustRunAs","MayRunAs","MustRunAsNonRoot","RunAsAny"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		podSecurityPolicyIdRangeStrategyTypeRulePropEnum = append(podSecurityPolicyIdRangeStrategyTypeRulePropEnum, v)
+	}
+}
+
+const (
+
+	// PodSecurityPolicyIDRangeStrategyRuleMustRunAs captures enum value "MustRunAs"
+	PodSecurityPolicyIDRangeStrategyRuleMustRunAs string = "MustRunAs"
+
+	// PodSecurityPolicyIDRangeStrategyRuleMayRunAs captures enum value "MayRunAs"
+	PodSecurityPolicyIDRangeStrategyRuleMayRunAs string = "MayRunAs"
+
+	// PodSecurityPolicyIDRangeStrategyRuleMustRunAsNonRoot captures enum value "MustRunAsNonRoot"
+	PodSecurityPolicyIDRangeStrategyRuleMustRunAsNonRoot string = "MustRunAsNonRoot"
+
+	// PodSecurityPolicyIDRangeStrategyRuleRunAsAny captures enum value "RunAsAny"
+	PodSecurityPolicyIDRangeStrategyRuleRunAsAny string = "RunAsAny"
+)
+
+// prop value enum
+func (m *PodSecurityPolicyIDRangeStrategy) validateRuleEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, podSecurityPolicyIdRangeStrategyTypeRulePropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this pod security policy ID range strategy
+func (m *PodSecurityPolicyIDRangeStrategy) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateRanges(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRule(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PodSecurityPolicyIDRangeStrategy) validateRanges(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Ranges) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Ranges); i++ {
+		if swag.IsZero(m.Ranges[i]) { // not required
+			continue
+		}
+
+		if m.Ranges[i] != nil {
+			if err := m.Ranges[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("ranges" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *PodSecurityPolicyIDRangeStrategy) validateRule(formats strfmt.Registry) error {
+
+	if err := validate.RequiredString("rule", "body", string(m.Rule)); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateRuleEnum("rule", "body", m.Rule); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PodSecurityPolicyIDRangeStrategy) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PodSecurityPolicyIDRangeStrategy) UnmarshalBinary(b []byte) error {
+	var res PodSecurityPolicyIDRangeStrategy
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
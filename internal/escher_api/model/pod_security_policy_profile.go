@@ -0,0 +1,187 @@
+package model
+
+import (
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PodSecurityPolicyProfile pod security policy profile
+// swagger:model PodSecurityPolicyProfile
+type PodSecurityPolicyProfile struct {
+
+	// allow privilege escalation
+	AllowPrivilegeEscalation bool `json:"allowPrivilegeEscalation"`
+
+	// allowed capabilities
+	AllowedCapabilities []string `json:"allowedCapabilities"`
+
+	// default add capabilities
+	DefaultAddCapabilities []string `json:"defaultAddCapabilities"`
+
+	// description
+	Description string `json:"description,omitempty"`
+
+	// fs group
+	FsGroup *PodSecurityPolicyIDRangeStrategy `json:"fsGroup,omitempty"`
+
+	// host IPC
+	HostIPC bool `json:"hostIPC"`
+
+	// host network
+	HostNetwork bool `json:"hostNetwork"`
+
+	// host p ID
+	HostPID bool `json:"hostPID"`
+
+	// host ports
+	HostPorts []*PodSecurityPolicyRange `json:"hostPorts"`
+
+	// id
+	// Read Only: true
+	// Format: uuid
+	ID strfmt.UUID `json:"id,omitempty"`
+
+	// name
+	// Required: true
+	Name *string `json:"name"`
+
+	// privileged
+	Privileged bool `json:"privileged"`
+
+	// read only root filesystem
+	ReadOnlyRootFilesystem bool `json:"readOnlyRootFilesystem"`
+
+	// required drop capabilities
+	RequiredDropCapabilities []string `json:"requiredDropCapabilities"`
+
+	// run as group
+	RunAsGroup *PodSecurityPolicyIDRangeStrategy `json:"runAsGroup,omitempty"`
+
+	// run as user
+	RunAsUser *PodSecurityPolicyIDRangeStrategy `json:"runAsUser,omitempty"`
+
+	// supplemental groups
+	SupplementalGroups *PodSecurityPolicyIDRangeStrategy `json:"supplementalGroups,omitempty"`
+
+	// volumes
+	Volumes []string `json:"volumes"`
+}
+
+// Validate validates this pod security policy profile
+func (m *PodSecurityPolicyProfile) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateHostPorts(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	for name, strategy := range map[string]*PodSecurityPolicyIDRangeStrategy{
+		"fsGroup":            m.FsGroup,
+		"runAsGroup":         m.RunAsGroup,
+		"runAsUser":          m.RunAsUser,
+		"supplementalGroups": m.SupplementalGroups,
+	} {
+		if err := m.validateIDRangeStrategy(name, strategy, formats); err != nil {
+			res = append(res, err)
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PodSecurityPolicyProfile) validateHostPorts(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.HostPorts) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.HostPorts); i++ {
+		if swag.IsZero(m.HostPorts[i]) { // not required
+			continue
+		}
+
+		if m.HostPorts[i] != nil {
+			if err := m.HostPorts[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("hostPorts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *PodSecurityPolicyProfile) validateID(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PodSecurityPolicyProfile) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PodSecurityPolicyProfile) validateIDRangeStrategy(name string, strategy *PodSecurityPolicyIDRangeStrategy, formats strfmt.Registry) error {
+
+	if swag.IsZero(strategy) { // not required
+		return nil
+	}
+
+	if err := strategy.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName(name)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PodSecurityPolicyProfile) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PodSecurityPolicyProfile) UnmarshalBinary(b []byte) error {
+	var res PodSecurityPolicyProfile
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,41 @@
+package model
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// PodSecurityPolicyRange an inclusive range of ids or ports
+// swagger:model PodSecurityPolicyRange
+type PodSecurityPolicyRange struct {
+
+	// max
+	Max int64 `json:"max"`
+
+	// min
+	Min int64 `json:"min"`
+}
+
+// Validate validates this pod security policy range
+func (m *PodSecurityPolicyRange) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PodSecurityPolicyRange) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PodSecurityPolicyRange) UnmarshalBinary(b []byte) error {
+	var res PodSecurityPolicyRange
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -1,134 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package model
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-
-	strfmt "github.com/go-openapi/strfmt"
-)
-
-// NewPostCdConnectionsRuleParams creates a new PostCdConnectionsRuleParams object
-// with the default values initialized.
-func NewPostCdConnectionsRuleParams() *PostCdConnectionsRuleParams {
-	var ()
-	return &PostCdConnectionsRuleParams{
-
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewPostCdConnectionsRuleParamsWithTimeout creates a new PostCdConnectionsRuleParams object
-// with the default values initialized, and the ability to set a timeout on a request
-func NewPostCdConnectionsRuleParamsWithTimeout(timeout time.Duration) *PostCdConnectionsRuleParams {
-	var ()
-	return &PostCdConnectionsRuleParams{
-
-		timeout: timeout,
-	}
-}
-
-// NewPostCdConnectionsRuleParamsWithContext creates a new PostCdConnectionsRuleParams object
-// with the default values initialized, and the ability to set a context for a request
-func NewPostCdConnectionsRuleParamsWithContext(ctx context.Context) *PostCdConnectionsRuleParams {
-	var ()
-	return &PostCdConnectionsRuleParams{
-
-		Context: ctx,
-	}
-}
-
-// NewPostCdConnectionsRuleParamsWithHTTPClient creates a new PostCdConnectionsRuleParams object
-// with the default values initialized, and the ability to set a custom HTTPClient for a request
-func NewPostCdConnectionsRuleParamsWithHTTPClient(client *http.Client) *PostCdConnectionsRuleParams {
-	var ()
-	return &PostCdConnectionsRuleParams{
-		HTTPClient: client,
-	}
-}
-
-/*PostCdConnectionsRuleParams contains all the parameters to send to the API endpoint
-for the post cd connections rule operation typically these are written to a http.Request
-*/
-type PostCdConnectionsRuleParams struct {
-
-	/*Body*/
-	Body *CdConnectionRule
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithTimeout adds the timeout to the post cd connections rule params
-func (o *PostCdConnectionsRuleParams) WithTimeout(timeout time.Duration) *PostCdConnectionsRuleParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the post cd connections rule params
-func (o *PostCdConnectionsRuleParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the post cd connections rule params
-func (o *PostCdConnectionsRuleParams) WithContext(ctx context.Context) *PostCdConnectionsRuleParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the post cd connections rule params
-func (o *PostCdConnectionsRuleParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the post cd connections rule params
-func (o *PostCdConnectionsRuleParams) WithHTTPClient(client *http.Client) *PostCdConnectionsRuleParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the post cd connections rule params
-func (o *PostCdConnectionsRuleParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithBody adds the body to the post cd connections rule params
-func (o *PostCdConnectionsRuleParams) WithBody(body *CdConnectionRule) *PostCdConnectionsRuleParams {
-	o.SetBody(body)
-	return o
-}
-
-// SetBody adds the body to the post cd connections rule params
-func (o *PostCdConnectionsRuleParams) SetBody(body *CdConnectionRule) {
-	o.Body = body
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *PostCdConnectionsRuleParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-
-	if o.Body != nil {
-		if err := r.SetBodyParam(o.Body); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
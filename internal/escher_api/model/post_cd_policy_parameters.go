@@ -1,133 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package model
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	strfmt "github.com/go-openapi/strfmt"
-)
-
-// NewPostCdPolicyParams creates a new PostCdPolicyParams object
-// with the default values initialized.
-func NewPostCdPolicyParams() *PostCdPolicyParams {
-	var ()
-	return &PostCdPolicyParams{
-
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewPostCdPolicyParamsWithTimeout creates a new PostCdPolicyParams object
-// with the default values initialized, and the ability to set a timeout on a request
-func NewPostCdPolicyParamsWithTimeout(timeout time.Duration) *PostCdPolicyParams {
-	var ()
-	return &PostCdPolicyParams{
-
-		timeout: timeout,
-	}
-}
-
-// NewPostCdPolicyParamsWithContext creates a new PostCdPolicyParams object
-// with the default values initialized, and the ability to set a context for a request
-func NewPostCdPolicyParamsWithContext(ctx context.Context) *PostCdPolicyParams {
-	var ()
-	return &PostCdPolicyParams{
-
-		Context: ctx,
-	}
-}
-
-// NewPostCdPolicyParamsWithHTTPClient creates a new PostCdPolicyParams object
-// with the default values initialized, and the ability to set a custom HTTPClient for a request
-func NewPostCdPolicyParamsWithHTTPClient(client *http.Client) *PostCdPolicyParams {
-	var ()
-	return &PostCdPolicyParams{
-		HTTPClient: client,
-	}
-}
-
-/*PostCdPolicyParams contains all the parameters to send to the API endpoint
-for the post cd policy operation typically these are written to a http.Request
-*/
-type PostCdPolicyParams struct {
-
-	/*Body*/
-	Body *CdPolicy
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithTimeout adds the timeout to the post cd policy params
-func (o *PostCdPolicyParams) WithTimeout(timeout time.Duration) *PostCdPolicyParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the post cd policy params
-func (o *PostCdPolicyParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the post cd policy params
-func (o *PostCdPolicyParams) WithContext(ctx context.Context) *PostCdPolicyParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the post cd policy params
-func (o *PostCdPolicyParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the post cd policy params
-func (o *PostCdPolicyParams) WithHTTPClient(client *http.Client) *PostCdPolicyParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the post cd policy params
-func (o *PostCdPolicyParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithBody adds the body to the post cd policy params
-func (o *PostCdPolicyParams) WithBody(body *CdPolicy) *PostCdPolicyParams {
-	o.SetBody(body)
-	return o
-}
-
-// SetBody adds the body to the post cd policy params
-func (o *PostCdPolicyParams) SetBody(body *CdPolicy) {
-	o.Body = body
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *PostCdPolicyParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-
-	if o.Body != nil {
-		if err := r.SetBodyParam(o.Body); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
@@ -1,146 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package model
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-)
-
-// NewPostTrustedSignersParams creates a new PostTrustedSignersParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewPostTrustedSignersParams() *PostTrustedSignersParams {
-	return &PostTrustedSignersParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewPostTrustedSignersParamsWithTimeout creates a new PostTrustedSignersParams object
-// with the ability to set a timeout on a request.
-func NewPostTrustedSignersParamsWithTimeout(timeout time.Duration) *PostTrustedSignersParams {
-	return &PostTrustedSignersParams{
-		timeout: timeout,
-	}
-}
-
-// NewPostTrustedSignersParamsWithContext creates a new PostTrustedSignersParams object
-// with the ability to set a context for a request.
-func NewPostTrustedSignersParamsWithContext(ctx context.Context) *PostTrustedSignersParams {
-	return &PostTrustedSignersParams{
-		Context: ctx,
-	}
-}
-
-// NewPostTrustedSignersParamsWithHTTPClient creates a new PostTrustedSignersParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewPostTrustedSignersParamsWithHTTPClient(client *http.Client) *PostTrustedSignersParams {
-	return &PostTrustedSignersParams{
-		HTTPClient: client,
-	}
-}
-
-/* PostTrustedSignersParams contains all the parameters to send to the API endpoint
-   for the post trusted signers operation.
-
-   Typically these are written to a http.Request.
-*/
-type PostTrustedSignersParams struct {
-
-	// Body.
-	Body *TrustedSigner
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the post trusted signers params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *PostTrustedSignersParams) WithDefaults() *PostTrustedSignersParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the post trusted signers params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *PostTrustedSignersParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the post trusted signers params
-func (o *PostTrustedSignersParams) WithTimeout(timeout time.Duration) *PostTrustedSignersParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the post trusted signers params
-func (o *PostTrustedSignersParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the post trusted signers params
-func (o *PostTrustedSignersParams) WithContext(ctx context.Context) *PostTrustedSignersParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the post trusted signers params
-func (o *PostTrustedSignersParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the post trusted signers params
-func (o *PostTrustedSignersParams) WithHTTPClient(client *http.Client) *PostTrustedSignersParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the post trusted signers params
-func (o *PostTrustedSignersParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithBody adds the body to the post trusted signers params
-func (o *PostTrustedSignersParams) WithBody(body *TrustedSigner) *PostTrustedSignersParams {
-	o.SetBody(body)
-	return o
-}
-
-// SetBody adds the body to the post trusted signers params
-func (o *PostTrustedSignersParams) SetBody(body *TrustedSigner) {
-	o.Body = body
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *PostTrustedSignersParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Body != nil {
-		if err := r.SetBodyParam(o.Body); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
@@ -1,65 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package model
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	"github.com/go-openapi/strfmt"
-)
-
-// PostTrustedSignersReader is a Reader for the PostTrustedSigners structure.
-type PostTrustedSignersReader struct {
-	Formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *PostTrustedSignersReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 201:
-		result := NewPostTrustedSignersCreated()
-		if err := result.readResponse(response, consumer, o.Formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-	default:
-		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
-	}
-}
-
-// NewPostTrustedSignersCreated creates a PostTrustedSignersCreated with default headers values
-func NewPostTrustedSignersCreated() *PostTrustedSignersCreated {
-	return &PostTrustedSignersCreated{}
-}
-
-/* PostTrustedSignersCreated describes a response with status code 201, with default header values.
-
-Added
-*/
-type PostTrustedSignersCreated struct {
-	Payload *TrustedSigner
-}
-
-func (o *PostTrustedSignersCreated) Error() string {
-	return fmt.Sprintf("[POST /trustedSigners][%d] postTrustedSignersCreated  %+v", 201, o.Payload)
-}
-func (o *PostTrustedSignersCreated) GetPayload() *TrustedSigner {
-	return o.Payload
-}
-
-func (o *PostTrustedSignersCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(TrustedSigner)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
@@ -1,155 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package model
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-
-	strfmt "github.com/go-openapi/strfmt"
-)
-
-// NewPutCdRuleIDDeploymentRuleParams creates a new PutCdRuleIDDeploymentRuleParams object
-// with the default values initialized.
-func NewPutCdRuleIDDeploymentRuleParams() *PutCdRuleIDDeploymentRuleParams {
-	var ()
-	return &PutCdRuleIDDeploymentRuleParams{
-
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewPutCdRuleIDDeploymentRuleParamsWithTimeout creates a new PutCdRuleIDDeploymentRuleParams object
-// with the default values initialized, and the ability to set a timeout on a request
-func NewPutCdRuleIDDeploymentRuleParamsWithTimeout(timeout time.Duration) *PutCdRuleIDDeploymentRuleParams {
-	var ()
-	return &PutCdRuleIDDeploymentRuleParams{
-
-		timeout: timeout,
-	}
-}
-
-// NewPutCdRuleIDDeploymentRuleParamsWithContext creates a new PutCdRuleIDDeploymentRuleParams object
-// with the default values initialized, and the ability to set a context for a request
-func NewPutCdRuleIDDeploymentRuleParamsWithContext(ctx context.Context) *PutCdRuleIDDeploymentRuleParams {
-	var ()
-	return &PutCdRuleIDDeploymentRuleParams{
-
-		Context: ctx,
-	}
-}
-
-// NewPutCdRuleIDDeploymentRuleParamsWithHTTPClient creates a new PutCdRuleIDDeploymentRuleParams object
-// with the default values initialized, and the ability to set a custom HTTPClient for a request
-func NewPutCdRuleIDDeploymentRuleParamsWithHTTPClient(client *http.Client) *PutCdRuleIDDeploymentRuleParams {
-	var ()
-	return &PutCdRuleIDDeploymentRuleParams{
-		HTTPClient: client,
-	}
-}
-
-/*PutCdRuleIDDeploymentRuleParams contains all the parameters to send to the API endpoint
-for the put cd rule ID deployment rule operation typically these are written to a http.Request
-*/
-type PutCdRuleIDDeploymentRuleParams struct {
-
-	/*Body*/
-	Body *CdAppRule
-	/*RuleID
-	  ruleId (uid)
-
-	*/
-	RuleID strfmt.UUID
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithTimeout adds the timeout to the put cd rule ID deployment rule params
-func (o *PutCdRuleIDDeploymentRuleParams) WithTimeout(timeout time.Duration) *PutCdRuleIDDeploymentRuleParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the put cd rule ID deployment rule params
-func (o *PutCdRuleIDDeploymentRuleParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the put cd rule ID deployment rule params
-func (o *PutCdRuleIDDeploymentRuleParams) WithContext(ctx context.Context) *PutCdRuleIDDeploymentRuleParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the put cd rule ID deployment rule params
-func (o *PutCdRuleIDDeploymentRuleParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the put cd rule ID deployment rule params
-func (o *PutCdRuleIDDeploymentRuleParams) WithHTTPClient(client *http.Client) *PutCdRuleIDDeploymentRuleParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the put cd rule ID deployment rule params
-func (o *PutCdRuleIDDeploymentRuleParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithBody adds the body to the put cd rule ID deployment rule params
-func (o *PutCdRuleIDDeploymentRuleParams) WithBody(body *CdAppRule) *PutCdRuleIDDeploymentRuleParams {
-	o.SetBody(body)
-	return o
-}
-
-// SetBody adds the body to the put cd rule ID deployment rule params
-func (o *PutCdRuleIDDeploymentRuleParams) SetBody(body *CdAppRule) {
-	o.Body = body
-}
-
-// WithRuleID adds the ruleID to the put cd rule ID deployment rule params
-func (o *PutCdRuleIDDeploymentRuleParams) WithRuleID(ruleID strfmt.UUID) *PutCdRuleIDDeploymentRuleParams {
-	o.SetRuleID(ruleID)
-	return o
-}
-
-// SetRuleID adds the ruleId to the put cd rule ID deployment rule params
-func (o *PutCdRuleIDDeploymentRuleParams) SetRuleID(ruleID strfmt.UUID) {
-	o.RuleID = ruleID
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *PutCdRuleIDDeploymentRuleParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-
-	if o.Body != nil {
-		if err := r.SetBodyParam(o.Body); err != nil {
-			return err
-		}
-	}
-
-	// path param ruleId
-	if err := r.SetPathParam("ruleId", o.RuleID.String()); err != nil {
-		return err
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
@@ -1,68 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package model
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-
-	strfmt "github.com/go-openapi/strfmt"
-)
-
-// PutCdRuleIDDeploymentRuleReader is a Reader for the PutCdRuleIDDeploymentRule structure.
-type PutCdRuleIDDeploymentRuleReader struct {
-	Formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *PutCdRuleIDDeploymentRuleReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewPutCdRuleIDDeploymentRuleOK()
-		if err := result.readResponse(response, consumer, o.Formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-
-	default:
-		return nil, runtime.NewAPIError("unknown error", response, response.Code())
-	}
-}
-
-// NewPutCdRuleIDDeploymentRuleOK creates a PutCdRuleIDDeploymentRuleOK with default headers values
-func NewPutCdRuleIDDeploymentRuleOK() *PutCdRuleIDDeploymentRuleOK {
-	return &PutCdRuleIDDeploymentRuleOK{}
-}
-
-/*PutCdRuleIDDeploymentRuleOK handles this case with default header values.
-
-updated
-*/
-type PutCdRuleIDDeploymentRuleOK struct {
-	Payload *CdAppRule
-}
-
-func (o *PutCdRuleIDDeploymentRuleOK) Error() string {
-	return fmt.Sprintf("[PUT /cd/{ruleId}/deploymentRule][%d] putCdRuleIdDeploymentRuleOK  %+v", 200, o.Payload)
-}
-
-func (o *PutCdRuleIDDeploymentRuleOK) GetPayload() *CdAppRule {
-	return o.Payload
-}
-
-func (o *PutCdRuleIDDeploymentRuleOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(CdAppRule)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
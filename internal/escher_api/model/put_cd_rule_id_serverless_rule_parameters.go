@@ -1,170 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package model
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"context"
-	"net/http"
-	"time"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-	"github.com/go-openapi/strfmt"
-)
-
-// NewPutCdRuleIDServerlessRuleParams creates a new PutCdRuleIDServerlessRuleParams object,
-// with the default timeout for this client.
-//
-// Default values are not hydrated, since defaults are normally applied by the API server side.
-//
-// To enforce default values in parameter, use SetDefaults or WithDefaults.
-func NewPutCdRuleIDServerlessRuleParams() *PutCdRuleIDServerlessRuleParams {
-	return &PutCdRuleIDServerlessRuleParams{
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewPutCdRuleIDServerlessRuleParamsWithTimeout creates a new PutCdRuleIDServerlessRuleParams object
-// with the ability to set a timeout on a request.
-func NewPutCdRuleIDServerlessRuleParamsWithTimeout(timeout time.Duration) *PutCdRuleIDServerlessRuleParams {
-	return &PutCdRuleIDServerlessRuleParams{
-		timeout: timeout,
-	}
-}
-
-// NewPutCdRuleIDServerlessRuleParamsWithContext creates a new PutCdRuleIDServerlessRuleParams object
-// with the ability to set a context for a request.
-func NewPutCdRuleIDServerlessRuleParamsWithContext(ctx context.Context) *PutCdRuleIDServerlessRuleParams {
-	return &PutCdRuleIDServerlessRuleParams{
-		Context: ctx,
-	}
-}
-
-// NewPutCdRuleIDServerlessRuleParamsWithHTTPClient creates a new PutCdRuleIDServerlessRuleParams object
-// with the ability to set a custom HTTPClient for a request.
-func NewPutCdRuleIDServerlessRuleParamsWithHTTPClient(client *http.Client) *PutCdRuleIDServerlessRuleParams {
-	return &PutCdRuleIDServerlessRuleParams{
-		HTTPClient: client,
-	}
-}
-
-/* PutCdRuleIDServerlessRuleParams contains all the parameters to send to the API endpoint
-   for the put cd rule ID serverless rule operation.
-
-   Typically these are written to a http.Request.
-*/
-type PutCdRuleIDServerlessRuleParams struct {
-
-	// Body.
-	Body *CdServerlessRule
-
-	/* RuleID.
-
-	   ruleId (uid)
-
-	   Format: uuid
-	*/
-	RuleID strfmt.UUID
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithDefaults hydrates default values in the put cd rule ID serverless rule params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *PutCdRuleIDServerlessRuleParams) WithDefaults() *PutCdRuleIDServerlessRuleParams {
-	o.SetDefaults()
-	return o
-}
-
-// SetDefaults hydrates default values in the put cd rule ID serverless rule params (not the query body).
-//
-// All values with no default are reset to their zero value.
-func (o *PutCdRuleIDServerlessRuleParams) SetDefaults() {
-	// no default values defined for this parameter
-}
-
-// WithTimeout adds the timeout to the put cd rule ID serverless rule params
-func (o *PutCdRuleIDServerlessRuleParams) WithTimeout(timeout time.Duration) *PutCdRuleIDServerlessRuleParams {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the put cd rule ID serverless rule params
-func (o *PutCdRuleIDServerlessRuleParams) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the put cd rule ID serverless rule params
-func (o *PutCdRuleIDServerlessRuleParams) WithContext(ctx context.Context) *PutCdRuleIDServerlessRuleParams {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the put cd rule ID serverless rule params
-func (o *PutCdRuleIDServerlessRuleParams) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the put cd rule ID serverless rule params
-func (o *PutCdRuleIDServerlessRuleParams) WithHTTPClient(client *http.Client) *PutCdRuleIDServerlessRuleParams {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the put cd rule ID serverless rule params
-func (o *PutCdRuleIDServerlessRuleParams) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithBody adds the body to the put cd rule ID serverless rule params
-func (o *PutCdRuleIDServerlessRuleParams) WithBody(body *CdServerlessRule) *PutCdRuleIDServerlessRuleParams {
-	o.SetBody(body)
-	return o
-}
-
-// SetBody adds the body to the put cd rule ID serverless rule params
-func (o *PutCdRuleIDServerlessRuleParams) SetBody(body *CdServerlessRule) {
-	o.Body = body
-}
-
-// WithRuleID adds the ruleID to the put cd rule ID serverless rule params
-func (o *PutCdRuleIDServerlessRuleParams) WithRuleID(ruleID strfmt.UUID) *PutCdRuleIDServerlessRuleParams {
-	o.SetRuleID(ruleID)
-	return o
-}
-
-// SetRuleID adds the ruleId to the put cd rule ID serverless rule params
-func (o *PutCdRuleIDServerlessRuleParams) SetRuleID(ruleID strfmt.UUID) {
-	o.RuleID = ruleID
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *PutCdRuleIDServerlessRuleParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-	if o.Body != nil {
-		if err := r.SetBodyParam(o.Body); err != nil {
-			return err
-		}
-	}
-
-	// path param ruleId
-	if err := r.SetPathParam("ruleId", o.RuleID.String()); err != nil {
-		return err
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
@@ -1,67 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package model
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"fmt"
-	"io"
-
-	"github.com/go-openapi/runtime"
-	strfmt "github.com/go-openapi/strfmt"
-)
-
-// PutCiPolicyPolicyIDReader is a Reader for the PutCiPolicyPolicyID structure.
-type PutCiPolicyPolicyIDReader struct {
-	Formats strfmt.Registry
-}
-
-// ReadResponse reads a server response into the received o.
-func (o *PutCiPolicyPolicyIDReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
-	switch response.Code() {
-	case 200:
-		result := NewPutCiPolicyPolicyIDOK()
-		if err := result.readResponse(response, consumer, o.Formats); err != nil {
-			return nil, err
-		}
-		return result, nil
-
-	default:
-		return nil, runtime.NewAPIError("unknown error", response, response.Code())
-	}
-}
-
-// NewPutCiPolicyPolicyIDOK creates a PutCiPolicyPolicyIDOK with default headers values
-func NewPutCiPolicyPolicyIDOK() *PutCiPolicyPolicyIDOK {
-	return &PutCiPolicyPolicyIDOK{}
-}
-
-/*PutCiPolicyPolicyIDOK handles this case with default header values.
-
-Success
-*/
-type PutCiPolicyPolicyIDOK struct {
-	Payload *CiPolicy
-}
-
-func (o *PutCiPolicyPolicyIDOK) Error() string {
-	return fmt.Sprintf("[PUT /ciPolicy/{policyId}][%d] putCiPolicyPolicyIdOK  %+v", 200, o.Payload)
-}
-
-func (o *PutCiPolicyPolicyIDOK) GetPayload() *CiPolicy {
-	return o.Payload
-}
-
-func (o *PutCiPolicyPolicyIDOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
-
-	o.Payload = new(CiPolicy)
-
-	// response payload
-	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
-		return err
-	}
-
-	return nil
-}
@@ -0,0 +1,209 @@
+package model
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ServerlessFunction A serverless function discovered in a cloud account, with its risk findings.
+// swagger:model ServerlessFunction
+type ServerlessFunction struct {
+
+	// arn
+	Arn string `json:"arn,omitempty"`
+
+	// cloud account name
+	CloudAccount string `json:"cloudAccount,omitempty"`
+
+	// data access risk
+	DataAccessRisk ServerlessDataAccessRisk `json:"dataAccessRisk,omitempty"`
+
+	// function permission risk
+	FunctionPermissionRisk ServerlessPolicyRisk `json:"functionPermissionRisk,omitempty"`
+
+	// id
+	// Format: uuid
+	ID strfmt.UUID `json:"id,omitempty"`
+
+	// is unused function
+	IsUnusedFunction bool `json:"isUnusedFunction,omitempty"`
+
+	// name
+	Name string `json:"name,omitempty"`
+
+	// publicly accessible risk
+	PubliclyAccessibleRisk ServerlessPubliclyAccessibleRisk `json:"publiclyAccessibleRisk,omitempty"`
+
+	// region
+	Region string `json:"region,omitempty"`
+
+	// risk
+	Risk ServerlessFunctionRiskLevel `json:"risk,omitempty"`
+
+	// secrets risk
+	SecretsRisk ServerlessSecretsRisk `json:"secretsRisk,omitempty"`
+
+	// vulnerability
+	Vulnerability VulnerabilitySeverity `json:"vulnerability,omitempty"`
+}
+
+// Validate validates this serverless function
+func (m *ServerlessFunction) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDataAccessRisk(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFunctionPermissionRisk(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePubliclyAccessibleRisk(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRisk(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSecretsRisk(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateVulnerability(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ServerlessFunction) validateDataAccessRisk(formats strfmt.Registry) error {
+	if swag.IsZero(m.DataAccessRisk) { // not required
+		return nil
+	}
+
+	if err := m.DataAccessRisk.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("dataAccessRisk")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *ServerlessFunction) validateFunctionPermissionRisk(formats strfmt.Registry) error {
+	if swag.IsZero(m.FunctionPermissionRisk) { // not required
+		return nil
+	}
+
+	if err := m.FunctionPermissionRisk.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("functionPermissionRisk")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *ServerlessFunction) validateID(formats strfmt.Registry) error {
+	if swag.IsZero(m.ID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ServerlessFunction) validatePubliclyAccessibleRisk(formats strfmt.Registry) error {
+	if swag.IsZero(m.PubliclyAccessibleRisk) { // not required
+		return nil
+	}
+
+	if err := m.PubliclyAccessibleRisk.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("publiclyAccessibleRisk")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *ServerlessFunction) validateRisk(formats strfmt.Registry) error {
+	if swag.IsZero(m.Risk) { // not required
+		return nil
+	}
+
+	if err := m.Risk.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("risk")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *ServerlessFunction) validateSecretsRisk(formats strfmt.Registry) error {
+	if swag.IsZero(m.SecretsRisk) { // not required
+		return nil
+	}
+
+	if err := m.SecretsRisk.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("secretsRisk")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *ServerlessFunction) validateVulnerability(formats strfmt.Registry) error {
+	if swag.IsZero(m.Vulnerability) { // not required
+		return nil
+	}
+
+	if err := m.Vulnerability.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("vulnerability")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ServerlessFunction) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ServerlessFunction) UnmarshalBinary(b []byte) error {
+	var res ServerlessFunction
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,118 @@
+package keyresolver
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	"terraform-provider-securecn/internal/utils"
+)
+
+const schemeSeparator = "://"
+
+const FileScheme = "file"
+const CosignScheme = "cosign"
+
+// kmsSchemes are the KMS references cosign exports public keys from
+var kmsSchemes = []string{"awskms", "azurekms", "gcpkms", "hashivault"}
+
+// Resolver resolves a reference to a signing key into its PEM encoded public key
+type Resolver interface {
+	Resolve(ctx context.Context, reference string) (string, error)
+}
+
+// FileResolver reads the public key from a local file, the reference is the path of the file
+type FileResolver struct{}
+
+func (FileResolver) Resolve(ctx context.Context, reference string) (string, error) {
+	log.Printf("[DEBUG] reading public key file %s", reference)
+
+	key, err := os.ReadFile(strings.TrimPrefix(reference, FileScheme+schemeSeparator))
+	if err != nil {
+		return "", fmt.Errorf("failed to read public key file: %v", err)
+	}
+
+	return string(key), nil
+}
+
+// CosignResolver exports the public key with the cosign CLI, the reference is passed to cosign as the key,
+// so it can be a cosign key file or any KMS reference cosign supports
+type CosignResolver struct {
+	CosignPath string
+}
+
+func (resolver CosignResolver) Resolve(ctx context.Context, reference string) (string, error) {
+	cosignPath := resolver.CosignPath
+	if cosignPath == "" {
+		cosignPath = "cosign"
+	}
+
+	key, err := utils.ExecCommand(ctx, cosignPath, "public-key", "--key", strings.TrimPrefix(reference, CosignScheme+schemeSeparator))
+	if err != nil {
+		return "", fmt.Errorf("failed to export public key of %s with cosign: %v", reference, err)
+	}
+
+	return key, nil
+}
+
+// SchemeResolver passes each reference to the resolver of its scheme,
+// references without a scheme are passed to the default resolver
+type SchemeResolver struct {
+	Resolvers map[string]Resolver
+	Default   Resolver
+}
+
+func (resolver SchemeResolver) Resolve(ctx context.Context, reference string) (string, error) {
+	schemeResolver, err := resolver.getResolver(reference)
+	if err != nil {
+		return "", err
+	}
+
+	return schemeResolver.Resolve(ctx, reference)
+}
+
+// Validate returns an error if there is no resolver for the scheme of the reference
+func (resolver SchemeResolver) Validate(reference string) error {
+	_, err := resolver.getResolver(reference)
+	return err
+}
+
+func (resolver SchemeResolver) getResolver(reference string) (Resolver, error) {
+	schemeEnd := strings.Index(reference, schemeSeparator)
+	if schemeEnd == -1 {
+		if resolver.Default == nil {
+			return nil, fmt.Errorf("key reference %s has no scheme", reference)
+		}
+		return resolver.Default, nil
+	}
+
+	scheme := reference[:schemeEnd]
+	schemeResolver, ok := resolver.Resolvers[scheme]
+	if !ok {
+		schemes := make([]string, 0, len(resolver.Resolvers))
+		for supportedScheme := range resolver.Resolvers {
+			schemes = append(schemes, supportedScheme)
+		}
+		sort.Strings(schemes)
+		return nil, fmt.Errorf("unsupported key reference scheme %s, expected one of %v", scheme, schemes)
+	}
+
+	return schemeResolver, nil
+}
+
+// NewResolver returns a resolver of local files, cosign references and the KMS references cosign supports
+func NewResolver() SchemeResolver {
+	cosignResolver := CosignResolver{}
+	resolvers := map[string]Resolver{
+		FileScheme:   FileResolver{},
+		CosignScheme: cosignResolver,
+	}
+	for _, kmsScheme := range kmsSchemes {
+		resolvers[kmsScheme] = cosignResolver
+	}
+
+	return SchemeResolver{Resolvers: resolvers, Default: FileResolver{}}
+}
@@ -0,0 +1,55 @@
+package keyresolver
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type stubResolver struct {
+	key string
+}
+
+func (resolver stubResolver) Resolve(ctx context.Context, reference string) (string, error) {
+	return resolver.key + reference, nil
+}
+
+func TestSchemeResolver(t *testing.T) {
+	resolver := SchemeResolver{
+		Resolvers: map[string]Resolver{"awskms": stubResolver{key: "kms:"}},
+		Default:   stubResolver{key: "default:"},
+	}
+
+	key, err := resolver.Resolve(context.Background(), "awskms:///alias/signing")
+	if err != nil || key != "kms:awskms:///alias/signing" {
+		t.Fatalf("expected the awskms resolver to be used, got %s, %v", key, err)
+	}
+
+	key, err = resolver.Resolve(context.Background(), "cosign.pub")
+	if err != nil || key != "default:cosign.pub" {
+		t.Fatalf("expected the default resolver to be used, got %s, %v", key, err)
+	}
+
+	if err := resolver.Validate("gcpkms://projects/p/locations/l/keyRings/r/cryptoKeys/k"); err == nil {
+		t.Fatal("expected an unsupported scheme error")
+	}
+}
+
+func TestFileResolver(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "cosign.pub")
+	if err := os.WriteFile(keyFile, []byte("public key"), 0600); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	for _, reference := range []string{keyFile, FileScheme + "://" + keyFile} {
+		key, err := NewResolver().Resolve(context.Background(), reference)
+		if err != nil || key != "public key" {
+			t.Fatalf("expected the key file to be read for %s, got %s, %v", reference, key, err)
+		}
+	}
+
+	if _, err := NewResolver().Resolve(context.Background(), filepath.Join(t.TempDir(), "missing.pub")); err == nil {
+		t.Fatal("expected an error for a missing key file")
+	}
+}
@@ -0,0 +1,162 @@
+package utils
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+const caKeySize = 2048
+
+// GenerateRootCA returns a self signed CA certificate and its private key, PEM encoded.
+func GenerateRootCA(commonName string, validity time.Duration) (string, string, error) {
+	key, err := rsa.GenerateKey(rand.Reader, caKeySize)
+	if err != nil {
+		return "", "", err
+	}
+
+	template, err := getCATemplate(commonName, validity, 1)
+	if err != nil {
+		return "", "", err
+	}
+
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return "", "", err
+	}
+
+	return encodeCertificate(cert), encodePrivateKey(key), nil
+}
+
+// GenerateIntermediateCA returns a CA certificate signed by the given CA and its private key, PEM encoded.
+func GenerateIntermediateCA(parentCertPEM string, parentKeyPEM string, commonName string, validity time.Duration) (string, string, error) {
+	parentCert, err := decodeCertificate(parentCertPEM)
+	if err != nil {
+		return "", "", err
+	}
+
+	parentKey, err := decodePrivateKey(parentKeyPEM)
+	if err != nil {
+		return "", "", err
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, caKeySize)
+	if err != nil {
+		return "", "", err
+	}
+
+	template, err := getCATemplate(commonName, validity, 0)
+	if err != nil {
+		return "", "", err
+	}
+
+	cert, err := x509.CreateCertificate(rand.Reader, template, parentCert, &key.PublicKey, parentKey)
+	if err != nil {
+		return "", "", err
+	}
+
+	return encodeCertificate(cert), encodePrivateKey(key), nil
+}
+
+func getCATemplate(commonName string, validity time.Duration, maxPathLen int) (*x509.Certificate, error) {
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             now,
+		NotAfter:              now.Add(validity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLen:            maxPathLen,
+		MaxPathLenZero:        maxPathLen == 0,
+	}, nil
+}
+
+func encodeCertificate(cert []byte) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert}))
+}
+
+func encodePrivateKey(key *rsa.PrivateKey) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+}
+
+func decodeCertificate(certPEM string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(certPEM))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("failed to decode PEM certificate")
+	}
+
+	return x509.ParseCertificate(block.Bytes)
+}
+
+func decodePrivateKey(keyPEM string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(keyPEM))
+	if block == nil || block.Type != "RSA PRIVATE KEY" {
+		return nil, errors.New("failed to decode PEM private key")
+	}
+
+	return x509.ParsePKCS1PrivateKey(block.Bytes)
+}
+
+// DecodePublicKey decodes a PEM encoded public key, as exported by openssl or cosign,
+// and returns it with its DER encoded SubjectPublicKeyInfo.
+func DecodePublicKey(keyPEM string) (crypto.PublicKey, []byte, error) {
+	block, rest := pem.Decode([]byte(strings.TrimSpace(keyPEM)))
+	if block == nil {
+		return nil, nil, errors.New("failed to decode PEM public key")
+	}
+	if len(strings.TrimSpace(string(rest))) != 0 {
+		return nil, nil, errors.New("expected a single PEM public key, found more data after it")
+	}
+	if strings.HasSuffix(block.Type, "PRIVATE KEY") {
+		return nil, nil, fmt.Errorf("expected a public key, got a %s", strings.ToLower(block.Type))
+	}
+
+	switch block.Type {
+	case "PUBLIC KEY":
+		publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse public key: %v", err)
+		}
+		return publicKey, block.Bytes, nil
+	case "RSA PUBLIC KEY":
+		publicKey, err := x509.ParsePKCS1PublicKey(block.Bytes)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse RSA public key: %v", err)
+		}
+		der, err := x509.MarshalPKIXPublicKey(publicKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		return publicKey, der, nil
+	default:
+		return nil, nil, fmt.Errorf("expected a PUBLIC KEY PEM block, got %s", block.Type)
+	}
+}
+
+// GetPublicKeyFingerprint returns the hex encoded SHA256 digest of the DER encoded SubjectPublicKeyInfo of a PEM public key.
+func GetPublicKeyFingerprint(keyPEM string) (string, error) {
+	_, der, err := DecodePublicKey(keyPEM)
+	if err != nil {
+		return "", err
+	}
+
+	digest := sha256.Sum256(der)
+	return hex.EncodeToString(digest[:]), nil
+}
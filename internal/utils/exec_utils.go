@@ -2,23 +2,22 @@ package utils
 
 import (
 	"bufio"
+	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"os"
 	"os/exec"
+	"strings"
+	"syscall"
 )
 
-func ExecBashCommand(command string) (string, error) {
+func ExecBashCommand(ctx context.Context, command string) (string, error) {
 	log.Printf("[DEBUG] executing bash command: %s", command)
 
-	cmd := exec.Command("bash", "-x", "-c", command)
-
-	stderr, _ := cmd.StderrPipe()
-
-	output, err := cmd.Output()
-
+	output, stderr, err := runCommand(ctx, "bash", "-x", "-c", command)
 	if err != nil {
-		scanner := bufio.NewScanner(stderr)
+		scanner := bufio.NewScanner(bytes.NewReader(stderr))
 		for scanner.Scan() {
 			log.Println("[DEBUG] " + scanner.Text())
 		}
@@ -26,14 +25,56 @@ func ExecBashCommand(command string) (string, error) {
 		return string(output), err
 	}
 
-	if output == nil {
-		return "", nil
+	return string(output), nil
+}
+
+func ExecCommand(ctx context.Context, name string, args ...string) (string, error) {
+	log.Printf("[DEBUG] executing command: %s %s", name, strings.Join(args, " "))
+
+	output, stderr, err := runCommand(ctx, name, args...)
+	if err != nil {
+		if _, ok := err.(*exec.ExitError); ok {
+			return string(output), fmt.Errorf("%s: %s", err, stderr)
+		}
+
+		return string(output), err
 	}
 
 	return string(output), nil
 }
 
-func ExecuteScript(scriptPath string, multiClusterCertsFolder string, skipReadyCheck bool, kubeconfig string) (string, error) {
+// runCommand runs the command in its own process group, so when the context is done
+// the processes the command started are killed together with it
+func runCommand(ctx context.Context, name string, args ...string) ([]byte, []byte, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+
+	if err := cmd.Start(); err != nil {
+		return nil, nil, err
+	}
+
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+		case <-done:
+		}
+	}()
+
+	err := cmd.Wait()
+	close(done)
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return stdout.Bytes(), stderr.Bytes(), fmt.Errorf("%s interrupted: %w", name, ctxErr)
+	}
+
+	return stdout.Bytes(), stderr.Bytes(), err
+}
+
+func ExecuteScript(ctx context.Context, scriptPath string, multiClusterCertsFolder string, skipReadyCheck bool, kubeconfig string) (string, error) {
 	log.Printf("[DEBUG] executing script")
 
 	command := "./" + scriptPath
@@ -45,7 +86,7 @@ func ExecuteScript(scriptPath string, multiClusterCertsFolder string, skipReadyC
 		command = command + " --skip-ready-check"
 	}
 
-	output, err := ExecBashCommand(fmt.Sprintf("KUBECONFIG=%s %s", kubeconfig, command))
+	output, err := ExecBashCommand(ctx, fmt.Sprintf("KUBECONFIG=%s %s", kubeconfig, command))
 	if err != nil {
 		return output, err
 	}
@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestExecCommand(t *testing.T) {
+	output, err := ExecCommand(context.Background(), "echo", "hello")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if output != "hello\n" {
+		t.Fatalf("expected the output of the command, got %q", output)
+	}
+
+	_, err = ExecBashCommand(context.Background(), "echo failed >&2; exit 3")
+	if err == nil {
+		t.Fatalf("expected a failing command to return an error")
+	}
+
+	_, err = ExecCommand(context.Background(), "bash", "-c", "echo failed >&2; exit 3")
+	if err == nil || !strings.Contains(err.Error(), "failed") {
+		t.Fatalf("expected the error to contain the stderr of the command, got: %v", err)
+	}
+}
+
+func TestCancelledContextInterruptsCommands(t *testing.T) {
+	commands := map[string]func(ctx context.Context) (string, error){
+		"command": func(ctx context.Context) (string, error) {
+			return ExecCommand(ctx, "sleep", "30")
+		},
+		"bash command": func(ctx context.Context) (string, error) {
+			return ExecBashCommand(ctx, "sleep 30")
+		},
+		// the background sleep keeps the output open, it has to be killed with the shell
+		"bash command with child processes": func(ctx context.Context) (string, error) {
+			return ExecBashCommand(ctx, "sleep 30 & wait")
+		},
+	}
+
+	for name, command := range commands {
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		start := time.Now()
+		_, err := command(ctx)
+		cancel()
+
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("expected the %s to be interrupted by its context, got: %v", name, err)
+		}
+		if elapsed := time.Since(start); elapsed > 5*time.Second {
+			t.Errorf("%s returned %s after its context was done", name, elapsed)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := ExecCommand(ctx, "echo", "hello"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected a command with a cancelled context not to start, got: %v", err)
+	}
+}
@@ -8,9 +8,10 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"strings"
 )
 
-func ExtractTarGz(gzipStream io.Reader) error {
+func ExtractTarGz(gzipStream io.Reader, destination string) error {
 	log.Print("[DEBUG] untaring file")
 
 	uncompressedStream, err := gzip.NewReader(gzipStream)
@@ -31,11 +32,14 @@ func ExtractTarGz(gzipStream io.Reader) error {
 			log.Fatalf("ExtractTarGz: Next() failed: %s", err.Error())
 		}
 
-		entryFile := header.Name
+		entryFile := filepath.Join(destination, header.Name)
+		if relativePath, err := filepath.Rel(destination, entryFile); err != nil || strings.HasPrefix(relativePath, "..") {
+			return errors.New("ExtractTarGz: illegal file path " + header.Name)
+		}
 
 		switch header.Typeflag {
 		case tar.TypeDir:
-			if err := os.Mkdir(entryFile, 0755); err != nil {
+			if err := os.MkdirAll(entryFile, 0755); err != nil {
 				//log.Fatalf("ExtractTarGz: Mkdir() failed: %s", err.Error())
 				return errors.New("ExtractTarGz: NewReader failed")
 
@@ -0,0 +1,45 @@
+package securecn
+
+import (
+	"context"
+	"log"
+	"terraform-provider-securecn/internal/client"
+	utils2 "terraform-provider-securecn/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceApiSecurityProfile() *schema.Resource {
+
+	return &schema.Resource{
+		ReadContext: dataSourceApiSecurityProfileRead,
+		Description: "A SecureCN api security profile, found by its name",
+		Schema: map[string]*schema.Schema{
+			nameFieldName: {
+				Description:  "The name of the api security profile",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func dataSourceApiSecurityProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Print("[DEBUG] reading api security profile")
+
+	httpClientWrapper := m.(client.HttpClientWrapper)
+
+	serviceApi := utils2.GetServiceApi(&httpClientWrapper)
+
+	profileId, err := serviceApi.GetApiSecurityProfileIdByName(ctx, httpClientWrapper.HttpClient, d.Get(nameFieldName).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(string(profileId))
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package securecn
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestApiSecurityProfileDataSourceFindsProfileByName(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	api.addObject(fakeApiApiSecurityProfiles, map[string]interface{}{"name": "permissive"})
+	profileId := api.addObject(fakeApiApiSecurityProfiles, map[string]interface{}{"name": "strict"})
+
+	d := schema.TestResourceDataRaw(t, DataSourceApiSecurityProfile().Schema, map[string]interface{}{nameFieldName: "strict"})
+
+	diags := dataSourceApiSecurityProfileRead(context.Background(), d, api.client())
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+
+	if d.Id() != profileId {
+		t.Fatalf("expected profile %s, got %s", profileId, d.Id())
+	}
+}
+
+func TestApiSecurityProfileDataSourceFailsWithoutProfile(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	api.addObject(fakeApiApiSecurityProfiles, map[string]interface{}{"name": "permissive"})
+
+	d := schema.TestResourceDataRaw(t, DataSourceApiSecurityProfile().Schema, map[string]interface{}{nameFieldName: "strict"})
+
+	diags := dataSourceApiSecurityProfileRead(context.Background(), d, api.client())
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "strict") {
+		t.Fatalf("expected an error for a profile that doesn't exist, got: %v", diags)
+	}
+	if d.Id() != "" {
+		t.Fatalf("expected no profile to be found, got %s", d.Id())
+	}
+}
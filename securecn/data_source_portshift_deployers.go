@@ -0,0 +1,135 @@
+package securecn
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"sort"
+	"strings"
+	"terraform-provider-securecn/internal/client"
+	model2 "terraform-provider-securecn/internal/escher_api/model"
+	utils2 "terraform-provider-securecn/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDeployers() *schema.Resource {
+
+	return &schema.Resource{
+		ReadContext: dataSourceDeployersRead,
+		Description: "The SecureCN deployers, optionally filtered by cluster and namespace",
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Description:  "Only deployers of the kubernetes cluster with this id",
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsUUID,
+			},
+			"namespace": {
+				Description: "Only deployers of this namespace",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"ids": {
+				Description: "The ids of the matching deployers",
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"deployers": {
+				Description: "The matching deployers",
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id":            {Type: schema.TypeString, Computed: true, Description: "The id of the deployer"},
+						"name":          {Type: schema.TypeString, Computed: true, Description: "The name of the deployer"},
+						"deployer_type": {Type: schema.TypeString, Computed: true, Description: "The type of the deployer, OperatorDeployer or SecureCnDeployer"},
+						"deployer_id":   {Type: schema.TypeString, Computed: true, Description: "The service account id of an operator deployer, the CD plugin id of a SecureCN deployer"},
+						"cluster_id":    {Type: schema.TypeString, Computed: true, Description: "The id of the kubernetes cluster of an operator deployer"},
+						"cluster_name":  {Type: schema.TypeString, Computed: true, Description: "The name of the kubernetes cluster of an operator deployer"},
+						"namespace":     {Type: schema.TypeString, Computed: true, Description: "The namespace of an operator deployer"},
+						"namespace_id":  {Type: schema.TypeString, Computed: true, Description: "The id of the namespace of an operator deployer"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDeployersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Print("[DEBUG] reading deployers")
+
+	httpClientWrapper := m.(client.HttpClientWrapper)
+
+	serviceApi := utils2.GetServiceApi(&httpClientWrapper)
+
+	var deployers []model2.Deployer
+	err := serviceApi.Deployers().List(ctx, httpClientWrapper.HttpClient, &deployers)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	namespace := d.Get("namespace").(string)
+
+	ids := make([]string, 0)
+	deployersInTf := make([]interface{}, 0)
+	for _, deployer := range deployers {
+		deployerInTf := getDeployerDataFromDeployer(deployer)
+		if clusterId != "" && deployerInTf["cluster_id"] != clusterId {
+			continue
+		}
+		if namespace != "" && deployerInTf["namespace"] != namespace {
+			continue
+		}
+
+		ids = append(ids, deployerInTf["id"].(string))
+		deployersInTf = append(deployersInTf, deployerInTf)
+	}
+
+	sort.Strings(ids)
+	sort.Slice(deployersInTf, func(i, j int) bool {
+		return deployersInTf[i].(map[string]interface{})["id"].(string) < deployersInTf[j].(map[string]interface{})["id"].(string)
+	})
+
+	_ = d.Set("ids", ids)
+	err = d.Set("deployers", deployersInTf)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	idDigest := sha256.Sum256([]byte(clusterId + "/" + namespace + "/" + strings.Join(ids, ",")))
+	d.SetId(hex.EncodeToString(idDigest[:]))
+
+	return nil
+}
+
+func getDeployerDataFromDeployer(deployer model2.Deployer) map[string]interface{} {
+	deployerInTf := map[string]interface{}{
+		"id":            string(deployer.ID()),
+		"name":          deployer.Deployer(),
+		"deployer_type": deployer.DeployerType(),
+		"deployer_id":   "",
+		"cluster_id":    "",
+		"cluster_name":  "",
+		"namespace":     "",
+		"namespace_id":  "",
+	}
+
+	if deployer.DeployerID() != nil {
+		deployerInTf["deployer_id"] = string(*deployer.DeployerID())
+	}
+
+	if operatorDeployer, ok := deployer.(*model2.OperatorDeployer); ok {
+		deployerInTf["cluster_id"] = string(operatorDeployer.ClusterID)
+		deployerInTf["cluster_name"] = operatorDeployer.Cluster
+		deployerInTf["namespace"] = operatorDeployer.Namespace
+		deployerInTf["namespace_id"] = string(operatorDeployer.NamespaceID)
+	}
+
+	return deployerInTf
+}
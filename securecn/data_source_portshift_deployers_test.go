@@ -0,0 +1,96 @@
+package securecn
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func addTestOperatorDeployer(api *fakeSecureCNApi, name string, clusterId string, namespace string) string {
+	return api.addObject(fakeApiDeployers, map[string]interface{}{
+		"deployer":     name,
+		"deployerType": "OperatorDeployer",
+		"deployerId":   uuid.New().String(),
+		"clusterId":    clusterId,
+		"namespace":    namespace,
+		"namespaceId":  uuid.New().String(),
+	})
+}
+
+func readTestDeployersDataSource(t *testing.T, api *fakeSecureCNApi, config map[string]interface{}) *schema.ResourceData {
+	d := schema.TestResourceDataRaw(t, DataSourceDeployers().Schema, config)
+
+	diags := dataSourceDeployersRead(context.Background(), d, api.client())
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+
+	return d
+}
+
+func sortedIds(ids ...string) []interface{} {
+	sort.Strings(ids)
+	sorted := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		sorted = append(sorted, id)
+	}
+
+	return sorted
+}
+
+func TestDeployersDataSourceFilters(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	clusterAId := api.addCluster("cluster-a", "default", "vault")
+	clusterBId := api.addCluster("cluster-b", "default")
+	defaultAId := addTestOperatorDeployer(api, "default-a", clusterAId, "default")
+	vaultAId := addTestOperatorDeployer(api, "vault-a", clusterAId, "vault")
+	defaultBId := addTestOperatorDeployer(api, "default-b", clusterBId, "default")
+	pluginId := api.addObject(fakeApiDeployers, map[string]interface{}{
+		"deployer":     "jenkins",
+		"deployerType": "SecureCnDeployer",
+		"deployerId":   uuid.New().String(),
+	})
+
+	d := readTestDeployersDataSource(t, api, map[string]interface{}{})
+	if expected := sortedIds(defaultAId, vaultAId, defaultBId, pluginId); !reflect.DeepEqual(d.Get("ids"), expected) {
+		t.Fatalf("expected all the deployers %v, got %v", expected, d.Get("ids"))
+	}
+
+	d = readTestDeployersDataSource(t, api, map[string]interface{}{"cluster_id": clusterAId})
+	if expected := sortedIds(defaultAId, vaultAId); !reflect.DeepEqual(d.Get("ids"), expected) {
+		t.Fatalf("expected the deployers of cluster-a %v, got %v", expected, d.Get("ids"))
+	}
+
+	d = readTestDeployersDataSource(t, api, map[string]interface{}{"namespace": "default"})
+	if expected := sortedIds(defaultAId, defaultBId); !reflect.DeepEqual(d.Get("ids"), expected) {
+		t.Fatalf("expected the deployers of the default namespaces %v, got %v", expected, d.Get("ids"))
+	}
+
+	d = readTestDeployersDataSource(t, api, map[string]interface{}{"cluster_id": clusterBId, "namespace": "default"})
+	if !reflect.DeepEqual(d.Get("ids"), []interface{}{defaultBId}) {
+		t.Fatalf("expected the deployer of the default namespace of cluster-b, got %v", d.Get("ids"))
+	}
+	deployer := d.Get("deployers.0").(map[string]interface{})
+	if deployer["name"] != "default-b" || deployer["deployer_type"] != "OperatorDeployer" || deployer["cluster_name"] != "cluster-b" || deployer["namespace"] != "default" {
+		t.Fatalf("unexpected deployer: %v", deployer)
+	}
+}
+
+func TestDeployersDataSourceWithoutMatches(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	clusterId := api.addCluster("cluster-a", "default")
+	addTestOperatorDeployer(api, "default-a", clusterId, "default")
+
+	d := readTestDeployersDataSource(t, api, map[string]interface{}{"cluster_id": clusterId, "namespace": "vault"})
+
+	if len(d.Get("ids").([]interface{})) != 0 || len(d.Get("deployers").([]interface{})) != 0 {
+		t.Fatalf("expected no deployers, got %v", d.Get("deployers"))
+	}
+	if d.Id() == "" {
+		t.Fatal("expected the data source to be read without matches")
+	}
+}
@@ -0,0 +1,75 @@
+package securecn
+
+import (
+	"context"
+	"log"
+	"terraform-provider-securecn/internal/client"
+	"terraform-provider-securecn/internal/escher_api/model"
+	utils2 "terraform-provider-securecn/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourcePodSecurityPolicyProfile() *schema.Resource {
+	profileSchema := getComputedSchema(ResourcePodSecurityPolicyProfile().Schema)
+	profileSchema[nameFieldName] = &schema.Schema{
+		Description:  "The name of the pod security policy profile",
+		Required:     true,
+		Type:         schema.TypeString,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourcePodSecurityPolicyProfileRead,
+		Description: "A SecureCN pod security policy profile, found by its name",
+		Schema:      profileSchema,
+	}
+}
+
+func dataSourcePodSecurityPolicyProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Print("[DEBUG] reading pod security policy profile by name")
+
+	httpClientWrapper := m.(client.HttpClientWrapper)
+
+	serviceApi := utils2.GetServiceApi(&httpClientWrapper)
+
+	profileId, err := serviceApi.GetPspIdByName(ctx, httpClientWrapper.HttpClient, d.Get(nameFieldName).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	profile := &model.PodSecurityPolicyProfile{}
+	err = serviceApi.PodSecurityPolicyProfiles().Get(ctx, httpClientWrapper.HttpClient, profileId, profile)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(string(profileId))
+
+	return diag.FromErr(updatePodSecurityPolicyProfileMutableFields(d, profile))
+}
+
+// getComputedSchema returns a read only copy of a resource schema, for a data source exposing the same attributes
+func getComputedSchema(resourceSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	computedSchema := make(map[string]*schema.Schema, len(resourceSchema))
+	for fieldName, fieldSchema := range resourceSchema {
+		computedFieldSchema := &schema.Schema{
+			Type:        fieldSchema.Type,
+			Computed:    true,
+			Description: fieldSchema.Description,
+		}
+
+		switch elem := fieldSchema.Elem.(type) {
+		case *schema.Resource:
+			computedFieldSchema.Elem = &schema.Resource{Schema: getComputedSchema(elem.Schema)}
+		case *schema.Schema:
+			computedFieldSchema.Elem = &schema.Schema{Type: elem.Type}
+		}
+
+		computedSchema[fieldName] = computedFieldSchema
+	}
+
+	return computedSchema
+}
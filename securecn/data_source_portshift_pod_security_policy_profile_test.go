@@ -0,0 +1,54 @@
+package securecn
+
+import (
+	"context"
+	"testing"
+
+	model2 "terraform-provider-securecn/internal/escher_api/model"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestPodSecurityPolicyProfileDataSourceReadsProfileByName(t *testing.T) {
+	otherName := "privileged"
+	name := "restricted"
+	api := newFakeSecureCNApi(t)
+	api.addModel(fakeApiPspProfiles, &model2.PodSecurityPolicyProfile{Name: &otherName, Privileged: true})
+	profileId := api.addModel(fakeApiPspProfiles, &model2.PodSecurityPolicyProfile{
+		Name:                     &name,
+		HostNetwork:              true,
+		RequiredDropCapabilities: []string{"ALL"},
+		HostPorts:                []*model2.PodSecurityPolicyRange{{Min: 8080, Max: 8090}},
+		RunAsUser: &model2.PodSecurityPolicyIDRangeStrategy{
+			Rule:   model2.PodSecurityPolicyIDRangeStrategyRuleMustRunAs,
+			Ranges: []*model2.PodSecurityPolicyRange{{Min: 1000, Max: 2000}},
+		},
+		FsGroup: &model2.PodSecurityPolicyIDRangeStrategy{Rule: model2.PodSecurityPolicyIDRangeStrategyRuleRunAsAny},
+	})
+
+	d := schema.TestResourceDataRaw(t, DataSourcePodSecurityPolicyProfile().Schema, map[string]interface{}{nameFieldName: name})
+
+	diags := dataSourcePodSecurityPolicyProfileRead(context.Background(), d, api.client())
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+
+	if d.Id() != profileId {
+		t.Fatalf("expected profile %s, got %s", profileId, d.Id())
+	}
+	if !d.Get(pspProfileHostNetworkFieldName).(bool) || d.Get(pspProfilePrivilegedFieldName).(bool) || d.Get(pspProfileHostPortFieldName).(*schema.Set).Len() != 1 {
+		t.Fatalf("unexpected profile read: %v", d.State().Attributes)
+	}
+
+	runAsUser := pspProfileRunAsUserFieldName + ".0."
+	if d.Get(runAsUser+pspProfileRuleFieldName) != "MustRunAs" {
+		t.Fatalf("unexpected run as user read: %v", d.Get(pspProfileRunAsUserFieldName))
+	}
+	ranges := d.Get(runAsUser + pspProfileRangeFieldName).(*schema.Set).List()
+	if len(ranges) != 1 || ranges[0].(map[string]interface{})[pspProfileMinFieldName] != 1000 || ranges[0].(map[string]interface{})[pspProfileMaxFieldName] != 2000 {
+		t.Fatalf("unexpected run as user ranges read: %v", ranges)
+	}
+	if d.Get(pspProfileFsGroupFieldName+".0."+pspProfileRuleFieldName) != "RunAsAny" || len(d.Get(pspProfileRunAsGroupFieldName).([]interface{})) != 0 {
+		t.Fatalf("unexpected id range strategies read: %v, %v", d.Get(pspProfileFsGroupFieldName), d.Get(pspProfileRunAsGroupFieldName))
+	}
+}
@@ -0,0 +1,137 @@
+package securecn
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"sort"
+	"strings"
+	"terraform-provider-securecn/internal/client"
+	model2 "terraform-provider-securecn/internal/escher_api/model"
+	utils2 "terraform-provider-securecn/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceServerlessFunctions() *schema.Resource {
+
+	return &schema.Resource{
+		ReadContext: dataSourceServerlessFunctionsRead,
+		Description: "The serverless functions SecureCN discovered and their risk findings, optionally filtered by cloud account, region and name",
+		Schema: map[string]*schema.Schema{
+			scopeFieldCloudAccount: {
+				Description: "Only functions of the cloud account with this name",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"region": {
+				Description:  "Only functions of this AWS region",
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringMatch(awsRegionRegex, "must be an AWS region name, e.g. us-east-1"),
+			},
+			"name": {
+				Description: "Only functions with this name",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			serverlessRuleArnsFieldName: {
+				Description: "The ARNs of the matching functions",
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"functions": {
+				Description: "The matching functions",
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id":                                  {Type: schema.TypeString, Computed: true, Description: "The id of the function"},
+						"name":                                {Type: schema.TypeString, Computed: true, Description: "The name of the function"},
+						"arn":                                 {Type: schema.TypeString, Computed: true, Description: "The ARN of the function"},
+						scopeFieldCloudAccount:                {Type: schema.TypeString, Computed: true, Description: "The name of the cloud account of the function"},
+						"region":                              {Type: schema.TypeString, Computed: true, Description: "The AWS region of the function"},
+						validationFieldRisk:                   {Type: schema.TypeString, Computed: true, Description: "The risk level of the function"},
+						validationFieldVulnerability:          {Type: schema.TypeString, Computed: true, Description: "The highest vulnerability severity of the function"},
+						validationFieldSecretsRisk:            {Type: schema.TypeString, Computed: true, Description: "Whether secrets were found in the function"},
+						validationFieldFunctionPermissionRisk: {Type: schema.TypeString, Computed: true, Description: "The risk level of the permissions of the function"},
+						validationFieldPubliclyAccessibleRisk: {Type: schema.TypeString, Computed: true, Description: "The risk level of the public accessibility of the function"},
+						validationFieldDataAccessRisk:         {Type: schema.TypeString, Computed: true, Description: "The risk level of the data access of the function"},
+						validationFieldIsUnusedFunction:       {Type: schema.TypeBool, Computed: true, Description: "Whether the function is unused"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceServerlessFunctionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Print("[DEBUG] reading serverless functions")
+
+	httpClientWrapper := m.(client.HttpClientWrapper)
+
+	serviceApi := utils2.GetServiceApi(&httpClientWrapper)
+
+	functions, err := serviceApi.GetServerlessFunctions(ctx, httpClientWrapper.HttpClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudAccount := d.Get(scopeFieldCloudAccount).(string)
+	region := d.Get("region").(string)
+	name := d.Get("name").(string)
+
+	arns := make([]string, 0)
+	functionsInTf := make([]interface{}, 0)
+	for _, function := range functions {
+		if cloudAccount != "" && function.CloudAccount != cloudAccount {
+			continue
+		}
+		if region != "" && function.Region != region {
+			continue
+		}
+		if name != "" && function.Name != name {
+			continue
+		}
+
+		arns = append(arns, function.Arn)
+		functionsInTf = append(functionsInTf, getServerlessFunctionDataFromFunction(function))
+	}
+
+	sort.Strings(arns)
+	sort.Slice(functionsInTf, func(i, j int) bool {
+		return functionsInTf[i].(map[string]interface{})["arn"].(string) < functionsInTf[j].(map[string]interface{})["arn"].(string)
+	})
+
+	_ = d.Set(serverlessRuleArnsFieldName, arns)
+	err = d.Set("functions", functionsInTf)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	idDigest := sha256.Sum256([]byte(cloudAccount + "/" + region + "/" + name + "/" + strings.Join(arns, ",")))
+	d.SetId(hex.EncodeToString(idDigest[:]))
+
+	return nil
+}
+
+func getServerlessFunctionDataFromFunction(function *model2.ServerlessFunction) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                                  string(function.ID),
+		"name":                                function.Name,
+		"arn":                                 function.Arn,
+		scopeFieldCloudAccount:                function.CloudAccount,
+		"region":                              function.Region,
+		validationFieldRisk:                   string(function.Risk),
+		validationFieldVulnerability:          string(function.Vulnerability),
+		validationFieldSecretsRisk:            string(function.SecretsRisk),
+		validationFieldFunctionPermissionRisk: string(function.FunctionPermissionRisk),
+		validationFieldPubliclyAccessibleRisk: string(function.PubliclyAccessibleRisk),
+		validationFieldDataAccessRisk:         string(function.DataAccessRisk),
+		validationFieldIsUnusedFunction:       function.IsUnusedFunction,
+	}
+}
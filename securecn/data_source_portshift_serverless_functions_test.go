@@ -0,0 +1,44 @@
+package securecn
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	model2 "terraform-provider-securecn/internal/escher_api/model"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestServerlessFunctionsDataSourceFilters(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	for _, function := range []*model2.ServerlessFunction{
+		{Name: "payments", Arn: "arn:aws:lambda:us-east-1:123456789012:function:payments", CloudAccount: "production", Region: "us-east-1", Risk: model2.ServerlessFunctionRiskLevelHIGH, SecretsRisk: model2.ServerlessSecretsRiskRISKIDENTIFIED},
+		{Name: "orders", Arn: "arn:aws:lambda:us-east-1:123456789012:function:orders", CloudAccount: "production", Region: "us-east-1", IsUnusedFunction: true},
+		{Name: "orders", Arn: "arn:aws:lambda:eu-west-1:123456789012:function:orders", CloudAccount: "production", Region: "eu-west-1"},
+		{Name: "payments", Arn: "arn:aws:lambda:us-east-1:210987654321:function:payments", CloudAccount: "staging", Region: "us-east-1"},
+	} {
+		api.addModel(fakeApiServerlessFunctions, function)
+	}
+
+	d := schema.TestResourceDataRaw(t, DataSourceServerlessFunctions().Schema, map[string]interface{}{
+		scopeFieldCloudAccount: "production",
+		"region":               "us-east-1",
+	})
+
+	diags := dataSourceServerlessFunctionsRead(context.Background(), d, api.client())
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+
+	expectedArns := []interface{}{
+		"arn:aws:lambda:us-east-1:123456789012:function:orders",
+		"arn:aws:lambda:us-east-1:123456789012:function:payments",
+	}
+	if !reflect.DeepEqual(d.Get(serverlessRuleArnsFieldName), expectedArns) {
+		t.Fatalf("expected arns %v, got %v", expectedArns, d.Get(serverlessRuleArnsFieldName))
+	}
+	if d.Get("functions.0.is_unused_function") != true || d.Get("functions.1.risk") != "HIGH" || d.Get("functions.1.secrets_risk") != "RISK_IDENTIFIED" {
+		t.Fatalf("unexpected functions: %v", d.Get("functions"))
+	}
+}
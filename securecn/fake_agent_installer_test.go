@@ -0,0 +1,140 @@
+package securecn
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"terraform-provider-securecn/internal/client"
+	"terraform-provider-securecn/internal/escher_api/escherClient"
+)
+
+const fakeInstallerInstall = "install"
+const fakeInstallerUninstall = "uninstall"
+
+// fakeAgentInstallerTimeout replaces the installation timeout while the fake installer is used
+const fakeAgentInstallerTimeout = 100 * time.Millisecond
+
+var errFakeInstallFailed = errors.New("fake controller installation failed")
+var errFakeUninstallFailed = errors.New("fake controller uninstallation failed")
+
+// fakeAgentInstallerCall is an install or uninstall the fake installer was asked for
+type fakeAgentInstallerCall struct {
+	method       string
+	installation agentInstallation
+}
+
+// fakeAgentInstaller records the installations instead of running kubectl, helm and the bundle scripts.
+// Installations and uninstallations fail while installErr and uninstallErr are set, and installations hang
+// until the timeout while hangInstall is set
+type fakeAgentInstaller struct {
+	mutex        sync.Mutex
+	calls        []fakeAgentInstallerCall
+	installed    map[string]agentInstallation
+	helmRevision int
+	installErr   error
+	uninstallErr error
+	hangInstall  bool
+	unreachable  bool
+	released     chan struct{}
+}
+
+// useFakeAgentInstaller installs the controllers of the clusters with a fake installer until the test ends
+func useFakeAgentInstaller(t *testing.T) *fakeAgentInstaller {
+	installer := &fakeAgentInstaller{
+		installed: make(map[string]agentInstallation),
+		released:  make(chan struct{}),
+	}
+
+	previousInstaller := clusterAgentInstaller
+	previousTimeout := agentInstallationTimeout
+	clusterAgentInstaller = installer
+	agentInstallationTimeout = fakeAgentInstallerTimeout
+	t.Cleanup(func() {
+		clusterAgentInstaller = previousInstaller
+		agentInstallationTimeout = previousTimeout
+		close(installer.released)
+	})
+
+	return installer
+}
+
+func (installer *fakeAgentInstaller) install(ctx context.Context, serviceApi *escherClient.MgmtServiceApiCtx, httpClientWrapper client.HttpClientWrapper, installation agentInstallation) (*helmInstallResult, error) {
+	installer.mutex.Lock()
+	installer.calls = append(installer.calls, fakeAgentInstallerCall{method: fakeInstallerInstall, installation: installation})
+	hangInstall := installer.hangInstall
+	installer.mutex.Unlock()
+
+	if hangInstall {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-installer.released:
+			return nil, errors.New("released a hanging fake installation")
+		}
+	}
+
+	installer.mutex.Lock()
+	defer installer.mutex.Unlock()
+
+	if installer.installErr != nil {
+		return nil, installer.installErr
+	}
+	installer.installed[installation.k8sContext] = installation
+
+	if installation.installMethod != installMethodHelm {
+		return nil, nil
+	}
+	installer.helmRevision++
+	return &helmInstallResult{revision: installer.helmRevision, valuesDigest: "values-of-" + string(installation.clusterId)}, nil
+}
+
+func (installer *fakeAgentInstaller) uninstall(ctx context.Context, serviceApi *escherClient.MgmtServiceApiCtx, httpClientWrapper client.HttpClientWrapper, installation agentInstallation) error {
+	installer.mutex.Lock()
+	defer installer.mutex.Unlock()
+
+	installer.calls = append(installer.calls, fakeAgentInstallerCall{method: fakeInstallerUninstall, installation: installation})
+	if installer.uninstallErr != nil {
+		return installer.uninstallErr
+	}
+	delete(installer.installed, installation.k8sContext)
+
+	return nil
+}
+
+func (installer *fakeAgentInstaller) isClusterReachable(ctx context.Context, k8sContext string) bool {
+	installer.mutex.Lock()
+	defer installer.mutex.Unlock()
+
+	return !installer.unreachable
+}
+
+func (installer *fakeAgentInstaller) printControllerPods(ctx context.Context, k8sContext string) {}
+
+// takeCalls returns the calls since the last takeCalls
+func (installer *fakeAgentInstaller) takeCalls() []fakeAgentInstallerCall {
+	installer.mutex.Lock()
+	defer installer.mutex.Unlock()
+
+	calls := installer.calls
+	installer.calls = nil
+	return calls
+}
+
+// installation returns the installation of a k8s context, false if nothing is installed there
+func (installer *fakeAgentInstaller) installation(k8sContext string) (agentInstallation, bool) {
+	installer.mutex.Lock()
+	defer installer.mutex.Unlock()
+
+	installation, ok := installer.installed[k8sContext]
+	return installation, ok
+}
+
+func (installer *fakeAgentInstaller) set(update func(installer *fakeAgentInstaller)) {
+	installer.mutex.Lock()
+	defer installer.mutex.Unlock()
+
+	update(installer)
+}
@@ -0,0 +1,620 @@
+package securecn
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"terraform-provider-securecn/internal/client"
+	"terraform-provider-securecn/internal/escher_api/escherClient"
+
+	"github.com/EscherAuth/escher/config"
+	"github.com/EscherAuth/escher/keydb"
+	escher_request "github.com/EscherAuth/escher/request"
+	"github.com/EscherAuth/escher/validator"
+	"github.com/google/uuid"
+)
+
+const fakeApiAccessKey = "access-key"
+const fakeApiCredentialScope = "global/services/portshift_request"
+
+var fakeApiSecretKey = base64.StdEncoding.EncodeToString([]byte("secret-key"))
+
+// the collections of objects the fake SecureCN API stores, named by their path in the API
+const fakeApiClusters = "kubernetesClusters"
+const fakeApiEnvironments = "environments"
+const fakeApiDeployers = "deployers"
+const fakeApiCdPolicies = "cdPolicy"
+const fakeApiCiPolicies = "ciPolicy"
+const fakeApiTrustedSigners = "trustedSigners"
+const fakeApiPspProfiles = "podSecurityPolicyProfiles"
+const fakeApiApiSecurityProfiles = "apiSecurityProfiles"
+const fakeApiConnectionRules = "connectionsRule"
+const fakeApiDeploymentRules = "deploymentRule"
+const fakeApiServerlessRules = "serverlessRule"
+const fakeApiServerlessFunctions = "serverless/functions"
+
+// fakeApiCollectionsPutCreated are the collections whose updates are answered with 201 instead of 200
+var fakeApiCollectionsPutCreated = map[string]bool{fakeApiTrustedSigners: true, fakeApiPspProfiles: true}
+
+var fakeApiCollectionRegex = regexp.MustCompile(`^/(kubernetesClusters|environments|deployers|cdPolicy|ciPolicy|trustedSigners|podSecurityPolicyProfiles)$`)
+var fakeApiObjectRegex = regexp.MustCompile(`^/(kubernetesClusters|environments|deployers|cdPolicy|ciPolicy|trustedSigners|podSecurityPolicyProfiles)/([^/]+)$`)
+var fakeApiRuleCollectionRegex = regexp.MustCompile(`^/cd/(connectionsRule|deploymentRule|serverlessRule)$`)
+var fakeApiRuleRegex = regexp.MustCompile(`^/cd/([^/]+)/(connectionsRule|deploymentRule|serverlessRule)$`)
+var fakeApiNameLookupRegex = regexp.MustCompile(`^/cd/(kubernetesClusters|podSecurityPolicyProfiles|apiSecurityProfiles)/([^/]+)$`)
+var fakeApiNamespacesRegex = regexp.MustCompile(`^/kubernetesClusters/([^/]+)/namespaces$`)
+var fakeApiBundleRegex = regexp.MustCompile(`^/kubernetesClusters/([^/]+)/download_bundle$`)
+
+// fakeSecureCNApi is an in-memory SecureCN management API. It stores the objects the provider manages as plain
+// JSON objects by collection and id, and rejects requests that aren't signed by fakeApiAccessKey
+type fakeSecureCNApi struct {
+	t      *testing.T
+	server *httptest.Server
+
+	mutex           sync.Mutex
+	objects         map[string]map[string]map[string]interface{}
+	serverFields    map[string]map[string]map[string]interface{}
+	namespaces      map[string][]map[string]interface{}
+	unsynced        map[string]int
+	serviceAccounts []map[string]interface{}
+	requests        []string
+	held            chan struct{}
+	bundleFiles     map[string]string
+
+	// withoutLabelExpressions simulates an older server that drops the namespace label expressions of environments
+	withoutLabelExpressions bool
+}
+
+func newFakeSecureCNApi(t *testing.T) *fakeSecureCNApi {
+	api := &fakeSecureCNApi{
+		t:            t,
+		objects:      make(map[string]map[string]map[string]interface{}),
+		serverFields: make(map[string]map[string]map[string]interface{}),
+		namespaces:   make(map[string][]map[string]interface{}),
+		unsynced:     make(map[string]int),
+	}
+
+	api.server = httptest.NewTLSServer(http.HandlerFunc(api.serveHTTP))
+	t.Cleanup(api.server.Close)
+
+	return api
+}
+
+// host is the host of the fake API, as the provider expects it in server_url
+func (api *fakeSecureCNApi) host() string {
+	serverUrl, _ := url.Parse(api.server.URL)
+	return serverUrl.Host
+}
+
+// clientWithKeys returns the provider client of the fake API, signing requests with the given keys
+func (api *fakeSecureCNApi) clientWithKeys(accessKey string, secretKey string) (client.HttpClientWrapper, error) {
+	httpClient := api.server.Client()
+	serviceApi, err := escherClient.CreateServiceApi(api.host(), accessKey, secretKey, httpClient)
+	if err != nil {
+		return client.HttpClientWrapper{}, err
+	}
+
+	return client.HttpClientWrapper{
+		AccessKey:    accessKey,
+		SecretKey:    secretKey,
+		BaseURL:      api.host(),
+		EscherClient: serviceApi,
+		HttpClient:   httpClient,
+	}, nil
+}
+
+// client returns the provider client of the fake API
+func (api *fakeSecureCNApi) client() client.HttpClientWrapper {
+	httpClientWrapper, err := api.clientWithKeys(fakeApiAccessKey, fakeApiSecretKey)
+	if err != nil {
+		api.t.Fatalf("err: %s", err)
+	}
+
+	return httpClientWrapper
+}
+
+// addObject stores an object as if it was created through the API and returns its id
+func (api *fakeSecureCNApi) addObject(collection string, object map[string]interface{}) string {
+	api.mutex.Lock()
+	defer api.mutex.Unlock()
+
+	return api.createObject(collection, object)
+}
+
+// addModel stores a model of the API as if it was created through the API and returns its id
+func (api *fakeSecureCNApi) addModel(collection string, model interface{}) string {
+	return api.addObject(collection, fakeApiObjectFromModel(api.t, model))
+}
+
+// addCluster stores a k8s cluster with the given namespaces and returns its id
+func (api *fakeSecureCNApi) addCluster(name string, namespaces ...string) string {
+	clusterId := api.addObject(fakeApiClusters, map[string]interface{}{"name": name})
+
+	api.mutex.Lock()
+	defer api.mutex.Unlock()
+
+	for _, namespace := range namespaces {
+		api.namespaces[clusterId] = append(api.namespaces[clusterId], map[string]interface{}{"id": uuid.New().String(), "name": namespace})
+	}
+
+	return clusterId
+}
+
+// syncNamespacesAfter reports no namespaces of a cluster for the given number of requests, like a cluster whose
+// controller didn't sync yet
+func (api *fakeSecureCNApi) syncNamespacesAfter(clusterId string, requests int) {
+	api.mutex.Lock()
+	defer api.mutex.Unlock()
+
+	api.unsynced[clusterId] = requests
+}
+
+// addServiceAccount stores a service account of the given namespace of a cluster and returns its id
+func (api *fakeSecureCNApi) addServiceAccount(clusterId string, namespace string, name string) string {
+	api.mutex.Lock()
+	defer api.mutex.Unlock()
+
+	serviceAccountId := uuid.New().String()
+	api.serviceAccounts = append(api.serviceAccounts, map[string]interface{}{
+		"id":                  serviceAccountId,
+		"name":                name,
+		"namespaceName":       namespace,
+		"kubernetesClusterId": clusterId,
+	})
+
+	return serviceAccountId
+}
+
+// setServerFields sets fields of a stored object the server owns, like the status of a cluster controller,
+// they are kept when the provider updates the object
+func (api *fakeSecureCNApi) setServerFields(collection string, id string, fields map[string]interface{}) {
+	api.mutex.Lock()
+	defer api.mutex.Unlock()
+
+	if api.serverFields[collection] == nil {
+		api.serverFields[collection] = make(map[string]map[string]interface{})
+	}
+	api.serverFields[collection][id] = fields
+	if object, ok := api.objects[collection][id]; ok {
+		api.completeObject(collection, object)
+	}
+}
+
+// dropLabelExpressions makes the server drop the namespace label expressions of the environments it stores,
+// like servers that don't support them
+func (api *fakeSecureCNApi) dropLabelExpressions() {
+	api.mutex.Lock()
+	defer api.mutex.Unlock()
+
+	api.withoutLabelExpressions = true
+}
+
+// setBundleFiles sets the files of the installation bundles, by their path in the bundle
+func (api *fakeSecureCNApi) setBundleFiles(files map[string]string) {
+	api.mutex.Lock()
+	defer api.mutex.Unlock()
+
+	api.bundleFiles = files
+}
+
+// object returns a copy of a stored object, nil if it doesn't exist
+func (api *fakeSecureCNApi) object(collection string, id string) map[string]interface{} {
+	api.mutex.Lock()
+	defer api.mutex.Unlock()
+
+	object, ok := api.objects[collection][id]
+	if !ok {
+		return nil
+	}
+
+	return copyFakeApiObject(object)
+}
+
+// decodeObject decodes a stored object into a model of the API
+func (api *fakeSecureCNApi) decodeObject(collection string, id string, model interface{}) {
+	object := api.object(collection, id)
+	if object == nil {
+		api.t.Fatalf("%s %s doesn't exist", collection, id)
+	}
+
+	encoded, _ := json.Marshal(object)
+	if err := json.Unmarshal(encoded, model); err != nil {
+		api.t.Fatalf("failed to decode %s %s: %s", collection, id, err)
+	}
+}
+
+// objectIds returns the sorted ids of the stored objects of a collection
+func (api *fakeSecureCNApi) objectIds(collection string) []string {
+	api.mutex.Lock()
+	defer api.mutex.Unlock()
+
+	ids := make([]string, 0, len(api.objects[collection]))
+	for id := range api.objects[collection] {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	return ids
+}
+
+// holdRequests makes the fake API hold the requests it gets, until the returned release function is called
+// or the client gives up on the request
+func (api *fakeSecureCNApi) holdRequests() func() {
+	held := make(chan struct{})
+	api.mutex.Lock()
+	api.held = held
+	api.mutex.Unlock()
+
+	var releaseOnce sync.Once
+	release := func() {
+		releaseOnce.Do(func() {
+			api.mutex.Lock()
+			api.held = nil
+			api.mutex.Unlock()
+			close(held)
+		})
+	}
+	api.t.Cleanup(release)
+
+	return release
+}
+
+// requestLog returns the "METHOD path" of every authenticated request the fake API served, in order
+func (api *fakeSecureCNApi) requestLog() []string {
+	api.mutex.Lock()
+	defer api.mutex.Unlock()
+
+	return append([]string(nil), api.requests...)
+}
+
+func (api *fakeSecureCNApi) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	err := verifyFakeApiSignature(r)
+	if err != nil {
+		writeFakeApiError(w, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	api.mutex.Lock()
+	held := api.held
+	api.mutex.Unlock()
+	if held != nil {
+		select {
+		case <-held:
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	api.mutex.Lock()
+	defer api.mutex.Unlock()
+
+	api.requests = append(api.requests, r.Method+" "+r.URL.Path)
+
+	path := strings.TrimPrefix(r.URL.Path, escherClient.DefaultBasePath)
+
+	switch {
+	case path == "/"+fakeApiServerlessFunctions && r.Method == http.MethodGet:
+		writeFakeApiObject(w, http.StatusOK, api.listObjects(fakeApiServerlessFunctions))
+	case path == "/deployers/serviceAccounts" && r.Method == http.MethodGet:
+		api.serveServiceAccounts(w, r.URL.Query())
+	case fakeApiNameLookupRegex.MatchString(path) && r.Method == http.MethodGet:
+		match := fakeApiNameLookupRegex.FindStringSubmatch(path)
+		api.serveIdByName(w, match[1], match[2])
+	case fakeApiNamespacesRegex.MatchString(path) && r.Method == http.MethodGet:
+		api.serveNamespaces(w, fakeApiNamespacesRegex.FindStringSubmatch(path)[1])
+	case fakeApiBundleRegex.MatchString(path) && r.Method == http.MethodGet:
+		api.serveBundle(w, fakeApiBundleRegex.FindStringSubmatch(path)[1])
+	case fakeApiCollectionRegex.MatchString(path):
+		api.serveCollection(w, r, fakeApiCollectionRegex.FindStringSubmatch(path)[1])
+	case fakeApiObjectRegex.MatchString(path):
+		match := fakeApiObjectRegex.FindStringSubmatch(path)
+		api.serveObject(w, r, match[1], match[2])
+	case fakeApiRuleCollectionRegex.MatchString(path):
+		api.serveCollection(w, r, fakeApiRuleCollectionRegex.FindStringSubmatch(path)[1])
+	case fakeApiRuleRegex.MatchString(path):
+		match := fakeApiRuleRegex.FindStringSubmatch(path)
+		api.serveObject(w, r, match[2], match[1])
+	default:
+		api.t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		writeFakeApiError(w, http.StatusNotFound, "no such api")
+	}
+}
+
+func (api *fakeSecureCNApi) serveCollection(w http.ResponseWriter, r *http.Request, collection string) {
+	switch r.Method {
+	case http.MethodGet:
+		writeFakeApiObject(w, http.StatusOK, api.listObjects(collection))
+	case http.MethodPost:
+		object, err := readFakeApiObject(r)
+		if err != nil {
+			writeFakeApiError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		id := api.createObject(collection, object)
+		writeFakeApiObject(w, http.StatusCreated, api.objects[collection][id])
+	default:
+		writeFakeApiError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+func (api *fakeSecureCNApi) serveObject(w http.ResponseWriter, r *http.Request, collection string, id string) {
+	if _, ok := api.objects[collection][id]; !ok {
+		writeFakeApiError(w, http.StatusNotFound, collection+" "+id+" not found")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeFakeApiObject(w, http.StatusOK, api.objects[collection][id])
+	case http.MethodPut:
+		object, err := readFakeApiObject(r)
+		if err != nil {
+			writeFakeApiError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		object["id"] = id
+		api.completeObject(collection, object)
+		api.objects[collection][id] = object
+
+		status := http.StatusOK
+		if fakeApiCollectionsPutCreated[collection] {
+			status = http.StatusCreated
+		}
+		writeFakeApiObject(w, status, object)
+	case http.MethodDelete:
+		delete(api.objects[collection], id)
+		delete(api.namespaces, id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeFakeApiError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+func (api *fakeSecureCNApi) serveIdByName(w http.ResponseWriter, collection string, name string) {
+	for id, object := range api.objects[collection] {
+		if object["name"] == name {
+			writeFakeApiObject(w, http.StatusOK, id)
+			return
+		}
+	}
+
+	writeFakeApiError(w, http.StatusNotFound, collection+" "+name+" not found")
+}
+
+func (api *fakeSecureCNApi) serveNamespaces(w http.ResponseWriter, clusterId string) {
+	if _, ok := api.objects[fakeApiClusters][clusterId]; !ok {
+		writeFakeApiError(w, http.StatusNotFound, "cluster "+clusterId+" not found")
+		return
+	}
+
+	namespaces := api.namespaces[clusterId]
+	if api.unsynced[clusterId] > 0 {
+		api.unsynced[clusterId]--
+		namespaces = nil
+	}
+	if namespaces == nil {
+		namespaces = make([]map[string]interface{}, 0)
+	}
+	writeFakeApiObject(w, http.StatusOK, namespaces)
+}
+
+func (api *fakeSecureCNApi) serveServiceAccounts(w http.ResponseWriter, query url.Values) {
+	serviceAccounts := make([]map[string]interface{}, 0)
+	for _, serviceAccount := range api.serviceAccounts {
+		if query.Get("kubernetesClusterId") != "" && serviceAccount["kubernetesClusterId"] != query.Get("kubernetesClusterId") {
+			continue
+		}
+		if query.Get("namespaceName") != "" && serviceAccount["namespaceName"] != query.Get("namespaceName") {
+			continue
+		}
+		serviceAccounts = append(serviceAccounts, serviceAccount)
+	}
+
+	writeFakeApiObject(w, http.StatusOK, serviceAccounts)
+}
+
+// serveBundle serves an installation bundle with the files set with setBundleFiles, or with a single manifest of the cluster
+func (api *fakeSecureCNApi) serveBundle(w http.ResponseWriter, clusterId string) {
+	if _, ok := api.objects[fakeApiClusters][clusterId]; !ok {
+		writeFakeApiError(w, http.StatusNotFound, "cluster "+clusterId+" not found")
+		return
+	}
+
+	files := api.bundleFiles
+	if files == nil {
+		files = map[string]string{"securecn/controller.yaml": "# SecureCN controller of cluster " + clusterId + "\n"}
+	}
+
+	buffer := new(bytes.Buffer)
+	gzipWriter := gzip.NewWriter(buffer)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for name, content := range files {
+		_ = tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg})
+		_, _ = tarWriter.Write([]byte(content))
+	}
+	_ = tarWriter.Close()
+	_ = gzipWriter.Close()
+
+	w.Header().Set("Content-Type", "application/gzip")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(buffer.Bytes())
+}
+
+func (api *fakeSecureCNApi) createObject(collection string, object map[string]interface{}) string {
+	id := uuid.New().String()
+	object = copyFakeApiObject(object)
+	object["id"] = id
+	api.completeObject(collection, object)
+
+	if api.objects[collection] == nil {
+		api.objects[collection] = make(map[string]map[string]interface{})
+	}
+	api.objects[collection][id] = object
+
+	return id
+}
+
+// completeObject fills in the fields the server owns or derives from other objects, like the names of referenced clusters
+func (api *fakeSecureCNApi) completeObject(collection string, object map[string]interface{}) {
+	id, _ := object["id"].(string)
+	for key, value := range api.serverFields[collection][id] {
+		object[key] = value
+	}
+
+	switch collection {
+	case fakeApiEnvironments:
+		kubernetesEnvironments, _ := object["kubernetesEnvironments"].([]interface{})
+		for _, kubernetesEnvironment := range kubernetesEnvironments {
+			kubernetesEnvironmentMap, ok := kubernetesEnvironment.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if api.withoutLabelExpressions {
+				delete(kubernetesEnvironmentMap, "namespaceLabelExpressions")
+			}
+			clusterId, _ := kubernetesEnvironmentMap["kubernetesCluster"].(string)
+			if cluster, ok := api.objects[fakeApiClusters][clusterId]; ok {
+				kubernetesEnvironmentMap["kubernetesClusterName"] = cluster["name"]
+			}
+		}
+	case fakeApiDeployers:
+		clusterId, _ := object["clusterId"].(string)
+		if cluster, ok := api.objects[fakeApiClusters][clusterId]; ok {
+			object["cluster"] = cluster["name"]
+		}
+		for _, namespace := range api.namespaces[clusterId] {
+			if namespace["id"] == object["namespaceId"] {
+				object["namespace"] = namespace["name"]
+			}
+		}
+	case fakeApiTrustedSigners:
+		// the server doesn't keep the order of the clusters
+		clusters, _ := object["trustedSignerClusters"].([]interface{})
+		sort.SliceStable(clusters, func(i, j int) bool {
+			idI, _ := clusters[i].(map[string]interface{})["id"].(string)
+			idJ, _ := clusters[j].(map[string]interface{})["id"].(string)
+			return idI > idJ
+		})
+	case fakeApiDeploymentRules:
+		app, _ := object["app"].(map[string]interface{})
+		podValidation, _ := app["podValidation"].(map[string]interface{})
+		podSecurityPolicy, _ := podValidation["podSecurityPolicy"].(map[string]interface{})
+		if podSecurityPolicy == nil {
+			return
+		}
+		profileId, _ := podSecurityPolicy["podSecurityPolicyId"].(string)
+		if profile, ok := api.objects[fakeApiPspProfiles][profileId]; ok {
+			podSecurityPolicy["podSecurityPolicyName"] = profile["name"]
+		}
+	}
+}
+
+// listObjects returns the objects of a collection ordered by id, so the provider can't rely on the order
+// the objects were created in
+func (api *fakeSecureCNApi) listObjects(collection string) []map[string]interface{} {
+	objects := make([]map[string]interface{}, 0, len(api.objects[collection]))
+	for _, object := range api.objects[collection] {
+		objects = append(objects, object)
+	}
+	sort.Slice(objects, func(i, j int) bool {
+		return objects[i]["id"].(string) < objects[j]["id"].(string)
+	})
+
+	return objects
+}
+
+// verifyFakeApiSignature verifies the request is signed with the escher keys of fakeApiAccessKey
+func verifyFakeApiSignature(r *http.Request) error {
+	escherConfig := config.Config{CredentialScope: fakeApiCredentialScope}
+	config.SetDefaults(&escherConfig)
+
+	escherRequest, err := escher_request.NewFromHTTPRequest(r)
+	if err != nil {
+		return err
+	}
+
+	_, err = validator.New(escherConfig).Validate(escherRequest, keydb.NewByKeyValuePair(fakeApiAccessKey, fakeApiSecretKey), nil)
+
+	return err
+}
+
+func readFakeApiObject(r *http.Request) (map[string]interface{}, error) {
+	object := make(map[string]interface{})
+	err := json.NewDecoder(r.Body).Decode(&object)
+
+	return object, err
+}
+
+func writeFakeApiObject(w http.ResponseWriter, status int, object interface{}) {
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(object)
+}
+
+func writeFakeApiError(w http.ResponseWriter, status int, message string) {
+	writeFakeApiObject(w, status, map[string]string{"message": message})
+}
+
+func fakeApiObjectFromModel(t *testing.T, model interface{}) map[string]interface{} {
+	encoded, err := json.Marshal(model)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	object := make(map[string]interface{})
+	_ = json.Unmarshal(encoded, &object)
+
+	return object
+}
+
+// copyFakeApiObject deep copies an object, so the provider never shares state with the fake API
+func copyFakeApiObject(object map[string]interface{}) map[string]interface{} {
+	encoded, _ := json.Marshal(object)
+	objectCopy := make(map[string]interface{})
+	_ = json.Unmarshal(encoded, &objectCopy)
+
+	return objectCopy
+}
+
+func TestFakeApiVerifiesEscherSignature(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+
+	_, err := api.client().EscherClient.GetServerlessFunctions(context.Background(), api.server.Client())
+	if err != nil {
+		t.Fatalf("expected a signed request to succeed, got: %s", err)
+	}
+
+	httpClientWrapper, err := api.clientWithKeys(fakeApiAccessKey, base64.StdEncoding.EncodeToString([]byte("other-secret-key")))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	_, err = httpClientWrapper.EscherClient.GetServerlessFunctions(context.Background(), api.server.Client())
+	if err == nil {
+		t.Fatalf("expected a request signed with another secret key to be rejected")
+	}
+
+	response, err := api.server.Client().Get(api.server.URL + "/api/serverless/functions")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	_ = response.Body.Close()
+	if response.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected an unsigned request to be rejected with %d, got %d", http.StatusUnauthorized, response.StatusCode)
+	}
+
+	if len(api.requestLog()) != 1 {
+		t.Fatalf("expected only the signed request to be served, got: %v", api.requestLog())
+	}
+}
@@ -0,0 +1,68 @@
+package securecn
+
+import (
+	"context"
+	"time"
+
+	"terraform-provider-securecn/internal/client"
+	"terraform-provider-securecn/internal/escher_api/escherClient"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// agentInstallation describes the controller installation of a cluster
+type agentInstallation struct {
+	clusterId          strfmt.UUID
+	controllerVersion  string
+	k8sContext         string
+	installMethod      string
+	multiClusterFolder string
+	tracingEnabled     bool
+	tokenInjection     bool
+	skipReadyCheck     bool
+	removeVault        bool
+}
+
+// agentInstaller installs and uninstalls the controller of a cluster on the k8s cluster
+type agentInstaller interface {
+	// install installs or upgrades the controller, the result is set only for helm installations
+	install(ctx context.Context, serviceApi *escherClient.MgmtServiceApiCtx, httpClientWrapper client.HttpClientWrapper, installation agentInstallation) (*helmInstallResult, error)
+	uninstall(ctx context.Context, serviceApi *escherClient.MgmtServiceApiCtx, httpClientWrapper client.HttpClientWrapper, installation agentInstallation) error
+	isClusterReachable(ctx context.Context, k8sContext string) bool
+	// printControllerPods logs the state of the controller pods, to debug a failed installation
+	printControllerPods(ctx context.Context, k8sContext string)
+}
+
+// clusterAgentInstaller installs the controllers of the clusters
+var clusterAgentInstaller agentInstaller = commandAgentInstaller{}
+
+// agentInstallationTimeout is how long the install script may run
+var agentInstallationTimeout = 15 * time.Minute
+
+// commandAgentInstaller installs the controller with kubectl, helm and the scripts of the cluster bundle
+type commandAgentInstaller struct{}
+
+func (commandAgentInstaller) install(ctx context.Context, serviceApi *escherClient.MgmtServiceApiCtx, httpClientWrapper client.HttpClientWrapper, installation agentInstallation) (*helmInstallResult, error) {
+	if installation.installMethod == installMethodHelm {
+		return installAgentWithHelm(ctx, serviceApi, httpClientWrapper, installation.clusterId, installation.controllerVersion, installation.k8sContext, installation.skipReadyCheck)
+	}
+
+	return nil, installAgent(ctx, serviceApi, httpClientWrapper, installation.clusterId, installation.controllerVersion, installation.k8sContext,
+		installation.multiClusterFolder, installation.tracingEnabled, installation.tokenInjection, installation.skipReadyCheck)
+}
+
+func (commandAgentInstaller) uninstall(ctx context.Context, serviceApi *escherClient.MgmtServiceApiCtx, httpClientWrapper client.HttpClientWrapper, installation agentInstallation) error {
+	if installation.installMethod == installMethodHelm {
+		return uninstallAgentWithHelm(ctx, installation.k8sContext)
+	}
+
+	return deleteAgent(installation.k8sContext, installation.removeVault, ctx, serviceApi, httpClientWrapper, installation.clusterId, installation.controllerVersion)
+}
+
+func (commandAgentInstaller) isClusterReachable(ctx context.Context, k8sContext string) bool {
+	return isClusterReachable(ctx, k8sContext)
+}
+
+func (commandAgentInstaller) printControllerPods(ctx context.Context, k8sContext string) {
+	_ = printPortshiftNamespaceBeforeDeletingController(ctx, k8sContext)
+}
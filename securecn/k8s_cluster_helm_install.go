@@ -0,0 +1,162 @@
+package securecn
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"syscall"
+	"terraform-provider-securecn/internal/client"
+	"terraform-provider-securecn/internal/escher_api/escherClient"
+	utils2 "terraform-provider-securecn/internal/utils"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+)
+
+const helmCommand = "helm"
+const helmReleaseName = "securecn"
+const helmReleaseNamespace = "portshift"
+const helmChartFileName = "Chart.yaml"
+const helmValuesFileName = "values.yaml"
+const helmInstallTimeout = "15m"
+
+type helmRelease struct {
+	Version int `json:"version"`
+}
+
+type helmInstallResult struct {
+	revision     int
+	valuesDigest string
+}
+
+// helmCommandRunner runs the helm CLI with the given arguments and returns its output
+var helmCommandRunner = func(ctx context.Context, args ...string) (string, error) {
+	return utils2.ExecCommand(ctx, helmCommand, args...)
+}
+
+// installAgentWithHelm installs or upgrades the controller release from the chart shipped in the cluster bundle.
+// The bundle is rendered by Panoptica from the cluster settings, so its values are passed to helm as is, and the digest
+// is of the values helm rendered the release with, the defaults of the chart coalesced with those of the bundle.
+func installAgentWithHelm(ctx context.Context, serviceApi *escherClient.MgmtServiceApiCtx, httpClientWrapper client.HttpClientWrapper, clusterId strfmt.UUID, controllerVersion string, k8sContext string, skipReadyCheck bool) (*helmInstallResult, error) {
+	log.Print("[DEBUG] installing agent with helm")
+
+	rootPath, _ := syscall.Getwd()
+	installationDir := installationDirPrefix + uuid.New().String()
+	if err := os.Mkdir(installationDir, os.ModePerm); err != nil {
+		return nil, err
+	}
+
+	os.Chdir(installationDir)
+	defer clearInstallationDir(rootPath, installationDir)
+
+	err := downloadAndExtractBundle(ctx, serviceApi, httpClientWrapper, clusterId, controllerVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	chartDir, err := findHelmChartDir(".")
+	if err != nil {
+		return nil, err
+	}
+
+	valuesFile := filepath.Join(chartDir, helmValuesFileName)
+	args := []string{"upgrade", helmReleaseName, chartDir, "--install",
+		"--namespace", helmReleaseNamespace, "--create-namespace",
+		"--kube-context", k8sContext,
+		"--values", valuesFile,
+		"--timeout", helmInstallTimeout,
+		"--output", "json"}
+	if !skipReadyCheck {
+		args = append(args, "--wait")
+	}
+
+	output, err := helmCommandRunner(ctx, args...)
+	if err != nil {
+		log.Print("[DEBUG] controller helm installation failed")
+		return nil, fmt.Errorf("%s:\n%s", err, output)
+	}
+
+	release := helmRelease{}
+	err = json.Unmarshal([]byte(output), &release)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse helm release: %s", err)
+	}
+
+	valuesDigest, err := getHelmValuesDigest(ctx, k8sContext, release.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &helmInstallResult{revision: release.Version, valuesDigest: valuesDigest}, nil
+}
+
+func uninstallAgentWithHelm(ctx context.Context, k8sContext string) error {
+	log.Printf("[DEBUG] uninstalling agent helm release from k8sContext: " + k8sContext)
+
+	output, err := helmCommandRunner(ctx, "uninstall", helmReleaseName,
+		"--namespace", helmReleaseNamespace,
+		"--kube-context", k8sContext)
+	log.Printf("[INFO] " + output)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func findHelmChartDir(root string) (string, error) {
+	chartDir := ""
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if chartDir == "" && !info.IsDir() && info.Name() == helmChartFileName {
+			chartDir = filepath.Dir(path)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if chartDir == "" {
+		return "", errors.New("the downloaded bundle doesn't contain a helm chart")
+	}
+
+	return chartDir, nil
+}
+
+// getHelmValuesDigest is the digest of the values a revision of the controller release was rendered with
+func getHelmValuesDigest(ctx context.Context, k8sContext string, revision int) (string, error) {
+	output, err := helmCommandRunner(ctx, "get", "values", helmReleaseName,
+		"--namespace", helmReleaseNamespace,
+		"--kube-context", k8sContext,
+		"--revision", strconv.Itoa(revision),
+		"--all",
+		"--output", "json")
+	if err != nil {
+		return "", fmt.Errorf("%s:\n%s", err, output)
+	}
+
+	var values map[string]interface{}
+	err = json.Unmarshal([]byte(output), &values)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse helm release values: %s", err)
+	}
+
+	// the keys of maps are marshalled in order, so equal values have equal digests
+	encodedValues, err := json.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+
+	digest := sha256.Sum256(encodedValues)
+	return hex.EncodeToString(digest[:]), nil
+}
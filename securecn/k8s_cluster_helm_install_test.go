@@ -0,0 +1,269 @@
+package securecn
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	utils2 "terraform-provider-securecn/internal/utils"
+
+	"github.com/go-openapi/strfmt"
+)
+
+const testHelmChart = `apiVersion: v2
+name: securecn
+version: 1.2.3
+`
+
+const testHelmValues = `global:
+  clusterName: production
+`
+
+// fakeHelm is the helm CLI of a single k8s context, it keeps the revision of the controller release and answers the
+// values of the release with renderedValues
+type fakeHelm struct {
+	t              *testing.T
+	calls          [][]string
+	revision       int
+	valuesFiles    []string
+	renderedValues string
+}
+
+// useFakeHelm runs the helm commands against a fakeHelm and serves bundles with the controller chart from the fake API
+func useFakeHelm(t *testing.T, api *fakeSecureCNApi) *fakeHelm {
+	helm := &fakeHelm{t: t, renderedValues: `{"global":{"clusterName":"production"}}`}
+
+	previousRunner := helmCommandRunner
+	helmCommandRunner = helm.run
+
+	// the bundle is extracted to the working directory
+	workingDir, _ := os.Getwd()
+	if err := os.Chdir(t.TempDir()); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	t.Cleanup(func() {
+		helmCommandRunner = previousRunner
+		_ = os.Chdir(workingDir)
+	})
+
+	api.setBundleFiles(map[string]string{
+		"securecn/helm/Chart.yaml":  testHelmChart,
+		"securecn/helm/values.yaml": testHelmValues,
+	})
+
+	return helm
+}
+
+func (helm *fakeHelm) run(ctx context.Context, args ...string) (string, error) {
+	helm.calls = append(helm.calls, args)
+
+	switch args[0] {
+	case "upgrade":
+		// the chart is read while the bundle is still extracted
+		if _, err := os.Stat(filepath.Join(args[2], helmChartFileName)); err != nil {
+			return "", err
+		}
+		values, err := ioutil.ReadFile(getTestHelmArg(args, "--values"))
+		if err != nil {
+			return "", err
+		}
+		helm.valuesFiles = append(helm.valuesFiles, string(values))
+		helm.revision++
+		return `{"name":"securecn","namespace":"portshift","version":` + strconv.Itoa(helm.revision) + `,"info":{"status":"deployed"}}`, nil
+	case "get":
+		if getTestHelmArg(args, "--revision") != strconv.Itoa(helm.revision) {
+			return "", errors.New("Error: release: revision not found")
+		}
+		return helm.renderedValues, nil
+	case "uninstall":
+		if helm.revision == 0 {
+			return "Error: uninstall: Release not loaded: securecn: release: not found", errors.New("exit status 1")
+		}
+		helm.revision = 0
+		return `release "securecn" uninstalled`, nil
+	}
+
+	helm.t.Fatalf("unexpected helm command: %v", args)
+	return "", nil
+}
+
+// takeCalls returns the helm commands run since the last call
+func (helm *fakeHelm) takeCalls() [][]string {
+	calls := helm.calls
+	helm.calls = nil
+
+	return calls
+}
+
+func getTestHelmArg(args []string, flag string) string {
+	for i, arg := range args {
+		if arg == flag && i+1 < len(args) {
+			return args[i+1]
+		}
+	}
+
+	return ""
+}
+
+func getTestHelmInstallation(clusterId string, skipReadyCheck bool) agentInstallation {
+	return agentInstallation{
+		clusterId:      strfmt.UUID(clusterId),
+		k8sContext:     testClusterContext,
+		installMethod:  installMethodHelm,
+		skipReadyCheck: skipReadyCheck,
+	}
+}
+
+func installTestHelmRelease(t *testing.T, api *fakeSecureCNApi, installation agentInstallation) *helmInstallResult {
+	t.Helper()
+
+	httpClientWrapper := api.client()
+	result, err := commandAgentInstaller{}.install(context.Background(), utils2.GetServiceApi(&httpClientWrapper), httpClientWrapper, installation)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	return result
+}
+
+func TestHelmInstallsReleaseWithValuesOfBundle(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	clusterId := api.addCluster("production")
+	helm := useFakeHelm(t, api)
+
+	result := installTestHelmRelease(t, api, getTestHelmInstallation(clusterId, false))
+	if result.revision != 1 || result.valuesDigest == "" {
+		t.Fatalf("unexpected helm install result: %+v", result)
+	}
+
+	calls := helm.takeCalls()
+	if len(calls) != 2 {
+		t.Fatalf("expected the release to be installed and its values to be read, got %v", calls)
+	}
+	chartDir := calls[0][2]
+	expectedInstall := []string{"upgrade", helmReleaseName, chartDir, "--install",
+		"--namespace", helmReleaseNamespace, "--create-namespace",
+		"--kube-context", testClusterContext,
+		"--values", filepath.Join(chartDir, helmValuesFileName),
+		"--timeout", helmInstallTimeout,
+		"--output", "json",
+		"--wait"}
+	if !reflect.DeepEqual(calls[0], expectedInstall) || !strings.HasSuffix(chartDir, filepath.Join("securecn", "helm")) {
+		t.Fatalf("unexpected helm install: %v", calls[0])
+	}
+	expectedGetValues := []string{"get", "values", helmReleaseName,
+		"--namespace", helmReleaseNamespace,
+		"--kube-context", testClusterContext,
+		"--revision", "1",
+		"--all",
+		"--output", "json"}
+	if !reflect.DeepEqual(calls[1], expectedGetValues) {
+		t.Fatalf("unexpected helm values read: %v", calls[1])
+	}
+	if len(helm.valuesFiles) != 1 || helm.valuesFiles[0] != testHelmValues {
+		t.Fatalf("expected the values of the bundle to be passed to helm, got %v", helm.valuesFiles)
+	}
+
+	// the installation dir is removed after the installation
+	entries, _ := ioutil.ReadDir(".")
+	if len(entries) != 0 {
+		t.Fatalf("expected the bundle to be removed, got %d entries", len(entries))
+	}
+}
+
+func TestHelmInstallWithoutReadyCheckDoesntWait(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	clusterId := api.addCluster("production")
+	helm := useFakeHelm(t, api)
+
+	installTestHelmRelease(t, api, getTestHelmInstallation(clusterId, true))
+
+	for _, arg := range helm.takeCalls()[0] {
+		if arg == "--wait" {
+			t.Fatal("expected helm not to wait for the controller when the ready check is skipped")
+		}
+	}
+}
+
+func TestHelmValuesDigestFollowsRenderedValues(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	clusterId := api.addCluster("production")
+	helm := useFakeHelm(t, api)
+	helm.renderedValues = `{"global":{"clusterName":"production","failClose":false}}`
+
+	installed := installTestHelmRelease(t, api, getTestHelmInstallation(clusterId, false))
+
+	helm.renderedValues = `{"global":{"failClose":false,"clusterName":"production"}}`
+	upgraded := installTestHelmRelease(t, api, getTestHelmInstallation(clusterId, false))
+	if upgraded.revision != 2 || upgraded.valuesDigest != installed.valuesDigest {
+		t.Fatalf("expected equal values to have equal digests, got %+v after %+v", upgraded, installed)
+	}
+
+	helm.renderedValues = `{"global":{"clusterName":"production","failClose":true}}`
+	changed := installTestHelmRelease(t, api, getTestHelmInstallation(clusterId, false))
+	if changed.revision != 3 || changed.valuesDigest == upgraded.valuesDigest {
+		t.Fatalf("expected the digest to change with the values, got %+v after %+v", changed, upgraded)
+	}
+}
+
+func TestHelmInstallFailsOnUnexpectedOutput(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	clusterId := api.addCluster("production")
+	useFakeHelm(t, api)
+	helmCommandRunner = func(ctx context.Context, args ...string) (string, error) {
+		return "Release \"securecn\" has been upgraded. Happy Helming!", nil
+	}
+
+	httpClientWrapper := api.client()
+	_, err := commandAgentInstaller{}.install(context.Background(), utils2.GetServiceApi(&httpClientWrapper), httpClientWrapper, getTestHelmInstallation(clusterId, false))
+	if err == nil || !strings.Contains(err.Error(), "failed to parse helm release") {
+		t.Fatalf("expected a helm release parse error, got: %v", err)
+	}
+}
+
+func TestHelmInstallRequiresChartInBundle(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	clusterId := api.addCluster("production")
+	helm := useFakeHelm(t, api)
+	api.setBundleFiles(map[string]string{"securecn/install_bundle.sh": "#!/bin/sh\n"})
+
+	httpClientWrapper := api.client()
+	_, err := commandAgentInstaller{}.install(context.Background(), utils2.GetServiceApi(&httpClientWrapper), httpClientWrapper, getTestHelmInstallation(clusterId, false))
+	if err == nil || !strings.Contains(err.Error(), "doesn't contain a helm chart") {
+		t.Fatalf("expected a missing chart error, got: %v", err)
+	}
+	if calls := helm.takeCalls(); len(calls) != 0 {
+		t.Fatalf("expected helm not to be run, got %v", calls)
+	}
+}
+
+func TestHelmUninstallsRelease(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	clusterId := api.addCluster("production")
+	helm := useFakeHelm(t, api)
+	installation := getTestHelmInstallation(clusterId, false)
+	installTestHelmRelease(t, api, installation)
+	helm.takeCalls()
+
+	httpClientWrapper := api.client()
+	err := commandAgentInstaller{}.uninstall(context.Background(), utils2.GetServiceApi(&httpClientWrapper), httpClientWrapper, installation)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	expectedUninstall := [][]string{{"uninstall", helmReleaseName, "--namespace", helmReleaseNamespace, "--kube-context", testClusterContext}}
+	if calls := helm.takeCalls(); !reflect.DeepEqual(calls, expectedUninstall) {
+		t.Fatalf("unexpected helm uninstall: %v", calls)
+	}
+
+	err = commandAgentInstaller{}.uninstall(context.Background(), utils2.GetServiceApi(&httpClientWrapper), httpClientWrapper, installation)
+	if err == nil {
+		t.Fatal("expected an error when uninstalling a release that doesn't exist")
+	}
+}
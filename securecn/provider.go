@@ -17,6 +17,8 @@ import (
 )
 
 const ClusterResourceName = "securecn_k8s_cluster"
+const ClusterBundleResourceName = "securecn_k8s_cluster_bundle"
+const MultiClusterCAResourceName = "securecn_multi_cluster_ca"
 const ConnectionRuleResourceName = "securecn_connection_rule"
 const EnvironmentResourceName = "securecn_environment"
 const DeploymentRuleResourceName = "securecn_deployment_rule"
@@ -25,12 +27,22 @@ const CiPolicyResourceName = "securecn_ci_policy"
 const CdPolicyResourceName = "securecn_cd_policy"
 const ServerlessRuleResourceName = "securecn_serverless_rule"
 const TrustedSignerResourceName = "securecn_trusted_signer"
+const PodSecurityPolicyProfileResourceName = "securecn_pod_security_policy_profile"
+const DeployersDataSourceName = "securecn_deployers"
+const ApiSecurityProfileDataSourceName = "securecn_api_security_profile"
+const PodSecurityPolicyProfileDataSourceName = "securecn_pod_security_policy_profile"
+const ServerlessFunctionsDataSourceName = "securecn_serverless_functions"
 const AccessKeyFieldName = "access_key"
 const SecretKeyFieldName = "secret_key"
 const ServerUrlFieldName = "server_url"
 
-func downloadFile(filepath string, url string) error {
-	resp, err := http.Get(url)
+func downloadFile(ctx context.Context, filepath string, url string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
 		return err
 	}
@@ -52,7 +64,7 @@ func configureProviderClient(ctx context.Context, d *schema.ResourceData) (inter
 		d.Get(SecretKeyFieldName).(string),
 		d.Get(ServerUrlFieldName).(string))
 
-	err := installKubectlOnDemand()
+	err := installKubectlOnDemand(ctx)
 	if err != nil {
 		return nil, diag.FromErr(err)
 	}
@@ -61,7 +73,7 @@ func configureProviderClient(ctx context.Context, d *schema.ResourceData) (inter
 	return httpClient, nil
 }
 
-func installKubectlOnDemand() error {
+func installKubectlOnDemand(ctx context.Context) error {
 	kubectlPath, err := exec.LookPath("kubectl")
 	if err != nil {
 		kubectlDir := "/tmp/terraformbin/"
@@ -77,7 +89,7 @@ func installKubectlOnDemand() error {
 			}
 
 			kubectlURL := fmt.Sprintf("https://dl.k8s.io/release/v1.23.0/bin/%s/%s/kubectl", runtime.GOOS, runtime.GOARCH)
-			err = downloadFile(kubectlPath, kubectlURL)
+			err = downloadFile(ctx, kubectlPath, kubectlURL)
 			if err != nil {
 				return err
 			}
@@ -127,15 +139,24 @@ func Provider() plugin.ProviderFunc {
 				},
 			},
 			ResourcesMap: map[string]*schema.Resource{
-				ClusterResourceName:        ResourceCluster(),
-				ConnectionRuleResourceName: ResourceConnectionRule(),
-				EnvironmentResourceName:    ResourceEnvironment(),
-				DeploymentRuleResourceName: ResourceDeploymentRule(),
-				DeployerResourceName:       ResourceDeployer(),
-				CiPolicyResourceName:       ResourceCiPolicy(),
-				CdPolicyResourceName:       ResourceCdPolicy(),
-				ServerlessRuleResourceName: ResourceServerlessRule(),
-				TrustedSignerResourceName:  ResourceTrustedSigner(),
+				ClusterResourceName:                  ResourceCluster(),
+				ClusterBundleResourceName:            ResourceClusterBundle(),
+				MultiClusterCAResourceName:           ResourceMultiClusterCA(),
+				ConnectionRuleResourceName:           ResourceConnectionRule(),
+				EnvironmentResourceName:              ResourceEnvironment(),
+				DeploymentRuleResourceName:           ResourceDeploymentRule(),
+				DeployerResourceName:                 ResourceDeployer(),
+				CiPolicyResourceName:                 ResourceCiPolicy(),
+				CdPolicyResourceName:                 ResourceCdPolicy(),
+				ServerlessRuleResourceName:           ResourceServerlessRule(),
+				TrustedSignerResourceName:            ResourceTrustedSigner(),
+				PodSecurityPolicyProfileResourceName: ResourcePodSecurityPolicyProfile(),
+			},
+			DataSourcesMap: map[string]*schema.Resource{
+				DeployersDataSourceName:                DataSourceDeployers(),
+				ApiSecurityProfileDataSourceName:       DataSourceApiSecurityProfile(),
+				PodSecurityPolicyProfileDataSourceName: DataSourcePodSecurityPolicyProfile(),
+				ServerlessFunctionsDataSourceName:      DataSourceServerlessFunctions(),
 			},
 			ConfigureContextFunc: configureProviderClient,
 		}
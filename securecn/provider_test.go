@@ -1,7 +1,25 @@
 package securecn
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
 	"testing"
+	"time"
+
+	"terraform-provider-securecn/internal/escher_api/escherClient"
+	model2 "terraform-provider-securecn/internal/escher_api/model"
+	utils2 "terraform-provider-securecn/internal/utils"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestProvider(t *testing.T) {
@@ -9,3 +27,249 @@ func TestProvider(t *testing.T) {
 		t.Fatalf("err: %s", err)
 	}
 }
+
+func TestCancelledContextInterruptsApiCalls(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	httpClientWrapper := api.client()
+	api.holdRequests()
+
+	resources := map[string]*schema.Resource{
+		ClusterResourceName:        ResourceCluster(),
+		EnvironmentResourceName:    ResourceEnvironment(),
+		DeployerResourceName:       ResourceDeployer(),
+		CdPolicyResourceName:       ResourceCdPolicy(),
+		CiPolicyResourceName:       ResourceCiPolicy(),
+		ServerlessRuleResourceName: ResourceServerlessRule(),
+		TrustedSignerResourceName:  ResourceTrustedSigner(),
+	}
+	for name, resource := range resources {
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(100*time.Millisecond, cancel)
+
+		d := resource.Data(&terraform.InstanceState{ID: uuid.New().String()})
+		start := time.Now()
+		diags := resource.ReadContext(ctx, d, httpClientWrapper)
+		cancel()
+
+		if !diags.HasError() {
+			t.Errorf("expected the read of %s to fail when its context is cancelled", name)
+		}
+		if elapsed := time.Since(start); elapsed > 5*time.Second {
+			t.Errorf("read of %s returned %s after its context was cancelled", name, elapsed)
+		}
+	}
+
+	if requests := len(api.requestLog()); requests != 0 {
+		t.Fatalf("expected the held requests not to be served, got: %v", api.requestLog())
+	}
+}
+
+func TestReadRemovesResourcesDeletedOutsideTerraform(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	httpClientWrapper := api.client()
+
+	resources := map[string]*schema.Resource{
+		ClusterResourceName:                  ResourceCluster(),
+		EnvironmentResourceName:              ResourceEnvironment(),
+		DeployerResourceName:                 ResourceDeployer(),
+		CdPolicyResourceName:                 ResourceCdPolicy(),
+		CiPolicyResourceName:                 ResourceCiPolicy(),
+		ConnectionRuleResourceName:           ResourceConnectionRule(),
+		DeploymentRuleResourceName:           ResourceDeploymentRule(),
+		ServerlessRuleResourceName:           ResourceServerlessRule(),
+		TrustedSignerResourceName:            ResourceTrustedSigner(),
+		PodSecurityPolicyProfileResourceName: ResourcePodSecurityPolicyProfile(),
+	}
+	for name, resource := range resources {
+		d := resource.Data(&terraform.InstanceState{ID: uuid.New().String()})
+		diags := resource.ReadContext(context.Background(), d, httpClientWrapper)
+		if diags.HasError() {
+			t.Errorf("expected the read of a deleted %s to succeed, got: %v", name, diags)
+		}
+		if d.Id() != "" {
+			t.Errorf("expected a deleted %s to be removed from the state", name)
+		}
+	}
+}
+
+func TestApiErrorsCarryStatusAndServerMessage(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	httpClientWrapper := api.client()
+	serviceApi := utils2.GetServiceApi(&httpClientWrapper)
+
+	envId := strfmt.UUID(uuid.New().String())
+	err := serviceApi.Environments().Get(context.Background(), httpClientWrapper.HttpClient, envId, &model2.Environment{})
+
+	var apiError *escherClient.APIError
+	if !errors.As(err, &apiError) {
+		t.Fatalf("expected an API error, got: %v", err)
+	}
+	if apiError.StatusCode != http.StatusNotFound || !escherClient.IsNotFound(err) {
+		t.Fatalf("expected a not found error, got status %d", apiError.StatusCode)
+	}
+	if !strings.Contains(apiError.Message, string(envId)) || !strings.Contains(err.Error(), apiError.Message) {
+		t.Fatalf("expected the error to contain the message of the server, got: %v", err)
+	}
+
+	_, err = serviceApi.GetKubernetesClusterIdByName(context.Background(), httpClientWrapper.HttpClient, "missing")
+	if !escherClient.IsNotFound(err) {
+		t.Fatalf("expected a not found error for a missing cluster name, got: %v", err)
+	}
+
+	err = serviceApi.Environments().Get(context.Background(), httpClientWrapper.HttpClient, envId, nil)
+	if !escherClient.IsNotFound(fmt.Errorf("wrapped: %w", err)) {
+		t.Fatalf("expected a wrapped not found error to be detected")
+	}
+}
+
+// testResourceLifecycle drives a resource the way terraform does, in process and without the terraform CLI.
+// It creates the resource with the first config, updates it to each of the following configs and deletes it,
+// after every apply it refreshes the state and expects an empty plan
+type testResourceLifecycle struct {
+	resource *schema.Resource
+	meta     interface{}
+	state    *terraform.InstanceState
+}
+
+func newTestResourceLifecycle(resource *schema.Resource, meta interface{}) *testResourceLifecycle {
+	return &testResourceLifecycle{resource: resource, meta: meta}
+}
+
+// apply applies a config and returns the state after refresh
+func (lifecycle *testResourceLifecycle) apply(t *testing.T, config map[string]interface{}) *terraform.InstanceState {
+	t.Helper()
+
+	state, diags := lifecycle.tryApply(config)
+	if diags.HasError() {
+		t.Fatalf("apply failed: %v", diags)
+	}
+
+	lifecycle.state = lifecycle.refresh(t, state)
+	if lifecycle.state == nil {
+		t.Fatalf("resource is gone after apply")
+	}
+
+	diff, err := lifecycle.resource.Diff(context.Background(), lifecycle.state, terraform.NewResourceConfigRaw(config), lifecycle.meta)
+	if err != nil {
+		t.Fatalf("plan after apply failed: %s", err)
+	}
+	if diff != nil && !diff.Empty() {
+		t.Fatalf("plan after apply isn't empty:\n%s", formatTestInstanceDiff(diff))
+	}
+
+	return lifecycle.state
+}
+
+// tryApply validates, plans and applies a config and returns the state the apply left
+func (lifecycle *testResourceLifecycle) tryApply(config map[string]interface{}) (*terraform.InstanceState, diag.Diagnostics) {
+	resourceConfig := terraform.NewResourceConfigRaw(config)
+
+	diags := lifecycle.resource.Validate(resourceConfig)
+	if diags.HasError() {
+		return lifecycle.state, diags
+	}
+
+	diff, err := lifecycle.resource.Diff(context.Background(), lifecycle.state, resourceConfig, lifecycle.meta)
+	if err != nil {
+		return lifecycle.state, diag.FromErr(err)
+	}
+	if diff == nil || diff.Empty() {
+		return lifecycle.state, nil
+	}
+
+	state, applyDiags := lifecycle.resource.Apply(context.Background(), lifecycle.state, diff, lifecycle.meta)
+	return state, append(diags, applyDiags...)
+}
+
+// refresh reads the resource, nil if it doesn't exist anymore
+func (lifecycle *testResourceLifecycle) refresh(t *testing.T, state *terraform.InstanceState) *terraform.InstanceState {
+	t.Helper()
+
+	state, diags := lifecycle.resource.RefreshWithoutUpgrade(context.Background(), state, lifecycle.meta)
+	if diags.HasError() {
+		t.Fatalf("refresh failed: %v", diags)
+	}
+	if state == nil || state.ID == "" {
+		return nil
+	}
+
+	return state
+}
+
+// importState imports the resource by its id and expects the same state the last apply left, except for the
+// attributes with one of the ignored prefixes, like ImportStateVerifyIgnore of an acceptance test step
+func (lifecycle *testResourceLifecycle) importState(t *testing.T, ignoredPrefixes ...string) {
+	t.Helper()
+
+	if lifecycle.resource.Importer == nil {
+		t.Fatalf("resource doesn't support import")
+	}
+
+	d := lifecycle.resource.Data(&terraform.InstanceState{ID: lifecycle.state.ID})
+	imported, err := lifecycle.resource.Importer.StateContext(context.Background(), d, lifecycle.meta)
+	if err != nil {
+		t.Fatalf("import failed: %s", err)
+	}
+	if len(imported) != 1 {
+		t.Fatalf("expected a single imported resource, got %d", len(imported))
+	}
+
+	importedState := lifecycle.refresh(t, imported[0].State())
+	if importedState == nil {
+		t.Fatalf("imported resource doesn't exist")
+	}
+
+	expected := filterTestStateAttributes(lifecycle.state.Attributes, ignoredPrefixes)
+	actual := filterTestStateAttributes(importedState.Attributes, ignoredPrefixes)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("imported state differs from the applied state\nexpected: %v\nactual:   %v", expected, actual)
+	}
+}
+
+// destroy deletes the resource
+func (lifecycle *testResourceLifecycle) destroy(t *testing.T) {
+	t.Helper()
+
+	diags := lifecycle.tryDestroy()
+	if diags.HasError() {
+		t.Fatalf("destroy failed: %v", diags)
+	}
+}
+
+// tryDestroy deletes the resource, the state is kept if the delete fails
+func (lifecycle *testResourceLifecycle) tryDestroy() diag.Diagnostics {
+	_, diags := lifecycle.resource.Apply(context.Background(), lifecycle.state, &terraform.InstanceDiff{Destroy: true}, lifecycle.meta)
+	if !diags.HasError() {
+		lifecycle.state = nil
+	}
+
+	return diags
+}
+
+func formatTestInstanceDiff(diff *terraform.InstanceDiff) string {
+	var attributes []string
+	for key, attribute := range diff.Attributes {
+		attributes = append(attributes, fmt.Sprintf("  %s: %q => %q (new computed: %t, requires new: %t)", key, attribute.Old, attribute.New, attribute.NewComputed, attribute.RequiresNew))
+	}
+	sort.Strings(attributes)
+
+	return strings.Join(attributes, "\n")
+}
+
+func filterTestStateAttributes(attributes map[string]string, ignoredPrefixes []string) map[string]string {
+	filtered := make(map[string]string)
+	for key, value := range attributes {
+		ignored := false
+		for _, prefix := range ignoredPrefixes {
+			if strings.HasPrefix(key, prefix) {
+				ignored = true
+				break
+			}
+		}
+		if !ignored {
+			filtered[key] = value
+		}
+	}
+
+	return filtered
+}
@@ -53,6 +53,9 @@ func ResourceCdPolicy() *schema.Resource {
 		ReadContext:   resourceCdPolicyRead,
 		UpdateContext: resourceCdPolicyUpdate,
 		DeleteContext: resourceCdPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Description:   "A SecureCN CD policy",
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
@@ -84,8 +87,16 @@ func ResourceCdPolicy() *schema.Resource {
 						"api_security_profile": {
 							Description:  "The id of the api security profile to use for this api policy",
 							Type:         schema.TypeString,
-							Required:     true,
+							Optional:     true,
 							ValidateFunc: validation.IsUUID,
+							ExactlyOneOf: []string{"api_security_policy.0.api_security_profile", "api_security_policy.0.api_security_profile_name"},
+						},
+						"api_security_profile_name": {
+							Description:  "The name of the api security profile to use for this api policy, resolved to its id on apply",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							ExactlyOneOf: []string{"api_security_policy.0.api_security_profile", "api_security_policy.0.api_security_profile_name"},
 						},
 						"enforcement_option": enforcementOptionSchema,
 					},
@@ -128,22 +139,18 @@ func resourceCdPolicyCreate(ctx context.Context, d *schema.ResourceData, m inter
 
 	serviceApi := utils2.GetServiceApi(&httpClientWrapper)
 
-	cdPolicy, err := getCdPolicyFromConfig(d, serviceApi)
+	cdPolicy, err := getCdPolicyFromConfig(ctx, d, serviceApi, httpClientWrapper)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	params := model2.PostCdPolicyParams{
-		Body:    cdPolicy,
-		Context: ctx,
-	}
-
-	cdPolicyCreated, err := serviceApi.PostCdPolicy(&params)
+	cdPolicyCreated := &model2.CdPolicy{}
+	err = serviceApi.CdPolicies().Create(ctx, httpClientWrapper.HttpClient, cdPolicy, cdPolicyCreated)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.SetId(string(cdPolicyCreated.Payload.ID))
+	d.SetId(string(cdPolicyCreated.ID))
 
 	return nil
 }
@@ -155,16 +162,13 @@ func resourceCdPolicyRead(ctx context.Context, d *schema.ResourceData, m interfa
 
 	serviceApi := utils2.GetServiceApi(&httpClientWrapper)
 
-	params := model2.GetCdPolicyParams{
-		Context: ctx,
-	}
-
-	cdPolicies, err := serviceApi.GetCdPolicy(&params)
+	var cdPolicies []*model2.CdPolicy
+	err := serviceApi.CdPolicies().List(ctx, httpClientWrapper.HttpClient, &cdPolicies)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	for _, cdPolicy := range cdPolicies.Payload {
+	for _, cdPolicy := range cdPolicies {
 		if string(cdPolicy.ID) == d.Id() {
 			return diag.FromErr(updateCdPolicyMutableFields(d, cdPolicy))
 		}
@@ -177,7 +181,68 @@ func resourceCdPolicyRead(ctx context.Context, d *schema.ResourceData, m interfa
 }
 
 func updateCdPolicyMutableFields(d *schema.ResourceData, policy *model2.CdPolicy) error {
-	return nil
+	log.Print("[DEBUG] updating cd policy mutable fields")
+
+	_ = d.Set(nameFieldName, policy.Name)
+	_ = d.Set(descriptionFieldName, policy.Description)
+
+	deployers := make([]string, 0, len(policy.Deployers))
+	for _, deployer := range policy.Deployers {
+		deployers = append(deployers, string(deployer))
+	}
+	err := d.Set("deployers", deployers)
+	if err != nil {
+		return err
+	}
+
+	apiSecurityPolicy := make([]interface{}, 0)
+	if policy.APISecurityCdPolicy != nil && policy.APISecurityCdPolicy.APISecurityProfile != nil {
+		apiSecurityPolicyInTf := map[string]interface{}{
+			"api_security_profile":      string(*policy.APISecurityCdPolicy.APISecurityProfile),
+			"api_security_profile_name": "",
+			"enforcement_option":        string(policy.APISecurityCdPolicy.EnforcementOption),
+		}
+		// a profile configured by name stays referenced by name
+		if apiSecurityProfileName := utils2.ReadNestedStringFromTF(d, "api_security_policy", "api_security_profile_name", 0); apiSecurityProfileName != "" {
+			apiSecurityPolicyInTf["api_security_profile"] = ""
+			apiSecurityPolicyInTf["api_security_profile_name"] = apiSecurityProfileName
+		}
+		apiSecurityPolicy = append(apiSecurityPolicy, apiSecurityPolicyInTf)
+	}
+	err = d.Set("api_security_policy", apiSecurityPolicy)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("permission_policy", getCdPolicyElementInTf(policy.PermissionCDPolicy))
+	if err != nil {
+		return err
+	}
+
+	secretPolicy := make([]interface{}, 0)
+	if policy.SecretCDPolicy != nil {
+		secretPolicy = append(secretPolicy, map[string]interface{}{
+			"permissible_vulnerability_level": string(policy.SecretCDPolicy.PermissibleVulnerabilityLevel),
+			"enforcement_option":              string(policy.SecretCDPolicy.EnforcementOption),
+		})
+	}
+	err = d.Set("secret_policy", secretPolicy)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("security_context_policy", getCdPolicyElementInTf(policy.SecurityContextCDPolicy))
+}
+
+func getCdPolicyElementInTf(element *model2.CdPolicyElement) []interface{} {
+	if element == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"permissible_vulnerability_level": string(element.PermissibleVulnerabilityLevel),
+		"enforcement_option":              string(element.EnforcementOption),
+	}}
 }
 
 func resourceCdPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -192,23 +257,18 @@ func resourceCdPolicyUpdate(ctx context.Context, d *schema.ResourceData, m inter
 
 	serviceApi := utils2.GetServiceApi(&httpClientWrapper)
 
-	cdPolicy, err := getCdPolicyFromConfig(d, serviceApi)
+	cdPolicy, err := getCdPolicyFromConfig(ctx, d, serviceApi, httpClientWrapper)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	params := model2.PutCdPolicyPolicyIDParams{
-		PolicyID: strfmt.UUID(d.Id()),
-		Body:     cdPolicy,
-		Context:  ctx,
-	}
-
-	cdPolicyUpdated, err := serviceApi.PutCdPolicyPolicyID(&params)
+	cdPolicyUpdated := &model2.CdPolicy{}
+	err = serviceApi.CdPolicies().Update(ctx, httpClientWrapper.HttpClient, strfmt.UUID(d.Id()), cdPolicy, cdPolicyUpdated)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.SetId(string(cdPolicyUpdated.Payload.ID))
+	d.SetId(string(cdPolicyUpdated.ID))
 
 	return resourceCdPolicyRead(ctx, d, m)
 }
@@ -220,12 +280,7 @@ func resourceCdPolicyDelete(ctx context.Context, d *schema.ResourceData, m inter
 
 	serviceApi := utils2.GetServiceApi(&httpClientWrapper)
 
-	params := model2.DeleteCdPolicyPolicyIDParams{
-		PolicyID: strfmt.UUID(d.Id()),
-		Context:  ctx,
-	}
-
-	_, err := serviceApi.DeleteCdPolicyPolicyID(&params)
+	err := serviceApi.CdPolicies().Delete(ctx, httpClientWrapper.HttpClient, strfmt.UUID(d.Id()))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -242,7 +297,7 @@ func validateCdPolicyConfig(d *schema.ResourceData) error {
 	return nil
 }
 
-func getCdPolicyFromConfig(d *schema.ResourceData, api *escherClient.MgmtServiceApiCtx) (*model2.CdPolicy, error) {
+func getCdPolicyFromConfig(ctx context.Context, d *schema.ResourceData, api *escherClient.MgmtServiceApiCtx, wrapper client.HttpClientWrapper) (*model2.CdPolicy, error) {
 	log.Print("[DEBUG] getting cd policy from config")
 
 	name := d.Get(nameFieldName).(string)
@@ -261,8 +316,17 @@ func getCdPolicyFromConfig(d *schema.ResourceData, api *escherClient.MgmtService
 	}
 
 	apiSecurityProfile := utils2.ReadNestedStringFromTF(d, "api_security_policy", "api_security_profile", 0)
+	apiSecurityProfileName := utils2.ReadNestedStringFromTF(d, "api_security_policy", "api_security_profile_name", 0)
 	enforcementOption := utils2.ReadNestedStringFromTF(d, "api_security_policy", "enforcement_option", 0)
 
+	if apiSecurityProfileName != "" {
+		profileId, err := api.GetApiSecurityProfileIdByName(ctx, wrapper.HttpClient, apiSecurityProfileName)
+		if err != nil {
+			return nil, err
+		}
+		apiSecurityProfile = string(profileId)
+	}
+
 	if apiSecurityProfile != "" && enforcementOption != "" {
 		apiSecurityProfileUUID := strfmt.UUID(apiSecurityProfile)
 		cdPolicy.APISecurityCdPolicy = &model2.APISecurityCdPolicyElement{
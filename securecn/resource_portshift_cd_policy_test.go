@@ -0,0 +1,54 @@
+package securecn
+
+import (
+	"testing"
+)
+
+func TestCdPolicyLifecycle(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	deployerId := api.addObject(fakeApiDeployers, map[string]interface{}{"deployer": "plugin", "deployerType": "SecureCnDeployer"})
+	apiSecurityProfileId := api.addObject(fakeApiApiSecurityProfiles, map[string]interface{}{"name": "strict"})
+	lifecycle := newTestResourceLifecycle(ResourceCdPolicy(), api.client())
+
+	state := lifecycle.apply(t, map[string]interface{}{
+		nameFieldName: "policy",
+		"deployers":   []interface{}{deployerId},
+		"permission_policy": []interface{}{
+			map[string]interface{}{"permissible_vulnerability_level": "MEDIUM", "enforcement_option": "FAIL"},
+		},
+	})
+	if policy := api.object(fakeApiCdPolicies, state.ID); policy["name"] != "policy" {
+		t.Fatalf("unexpected cd policy created: %v", policy)
+	}
+
+	updatedState := lifecycle.apply(t, map[string]interface{}{
+		nameFieldName:        "policy",
+		descriptionFieldName: "every check",
+		"deployers":          []interface{}{deployerId},
+		"api_security_policy": []interface{}{
+			map[string]interface{}{"api_security_profile_name": "strict", "enforcement_option": "IGNORE"},
+		},
+		"permission_policy": []interface{}{
+			map[string]interface{}{"permissible_vulnerability_level": "HIGH", "enforcement_option": "FAIL"},
+		},
+		"secret_policy": []interface{}{
+			map[string]interface{}{"permissible_vulnerability_level": "NO_KNOWN_RISK", "enforcement_option": "FAIL"},
+		},
+		"security_context_policy": []interface{}{
+			map[string]interface{}{"permissible_vulnerability_level": "NO_RISK", "enforcement_option": "IGNORE"},
+		},
+	})
+	policy := api.object(fakeApiCdPolicies, state.ID)
+	apiSecurityPolicy, _ := policy["apiSecurityCdPolicy"].(map[string]interface{})
+	if updatedState.ID != state.ID || apiSecurityPolicy["apiSecurityProfile"] != apiSecurityProfileId {
+		t.Fatalf("expected the cd policy to be updated in place with the api security profile resolved, got %s: %v", updatedState.ID, policy)
+	}
+
+	// a profile configured by name is imported by id
+	lifecycle.importState(t, "api_security_policy.0.api_security_profile")
+
+	lifecycle.destroy(t)
+	if ids := api.objectIds(fakeApiCdPolicies); len(ids) != 0 {
+		t.Fatalf("expected the cd policy to be deleted, got %v", ids)
+	}
+}
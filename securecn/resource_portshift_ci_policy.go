@@ -2,11 +2,15 @@ package securecn
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"path"
+	"regexp"
 	"terraform-provider-securecn/internal/client"
 	"terraform-provider-securecn/internal/escher_api/escherClient"
 	model2 "terraform-provider-securecn/internal/escher_api/model"
 	utils2 "terraform-provider-securecn/internal/utils"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -14,6 +18,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+var vulnerabilitySeverities = []string{"UNKNOWN", "LOW", "MEDIUM", "HIGH", "CRITICAL"}
+
+var dockerfileScanRuleIdRegex = regexp.MustCompile(`^[A-Z]+-[A-Z]+-[0-9]{4}$`)
+
+// ciPolicyDateFormat is the format of allowlist expiration dates, as the API returns them
+const ciPolicyDateFormat = "2006-01-02"
+
 func ResourceCiPolicy() *schema.Resource {
 
 	return &schema.Resource{
@@ -21,6 +32,9 @@ func ResourceCiPolicy() *schema.Resource {
 		ReadContext:   resourceCiPolicyRead,
 		UpdateContext: resourceCiPolicyUpdate,
 		DeleteContext: resourceCiPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Description:   "A SecureCN CI policy",
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
@@ -44,9 +58,64 @@ func ResourceCiPolicy() *schema.Resource {
 							Description:  "The level of risk accepted in this policy",
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validation.StringInSlice([]string{"UNKNOWN", "LOW", "MEDIUM", "HIGH", "CRITICAL"}, false),
+							ValidateFunc: validation.StringInSlice(vulnerabilitySeverities, false),
 						},
 						"enforcement_option": enforcementOptionSchema,
+						"fail_only_if_fix_available": {
+							Description: "Fail only on vulnerabilities a fix is available for",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+						"allowlist": {
+							Description: "Vulnerabilities and packages that don't fail this policy",
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"vulnerability": {
+										Description: "The name of the allowed vulnerability, e.g. CVE-2021-44228. When package_name is set too, the vulnerability is allowed only in that package",
+										Type:        schema.TypeString,
+										Optional:    true,
+										Default:     "",
+									},
+									"package_name": {
+										Description: "The name of the package whose vulnerabilities are allowed",
+										Type:        schema.TypeString,
+										Optional:    true,
+										Default:     "",
+									},
+									"expiration_date": {
+										Description:  "The date (YYYY-MM-DD) from which this entry no longer applies, the entry never expires when empty",
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "",
+										ValidateFunc: validateDate,
+									},
+								},
+							},
+						},
+						"grace_period": {
+							Description: "Vulnerabilities of a severity don't fail this policy for a number of days after they are published",
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"severity": {
+										Description:  "The severity of the vulnerabilities",
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(vulnerabilitySeverities, false),
+									},
+									"days": {
+										Description:  "The number of days after a vulnerability is published during which it doesn't fail this policy",
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -64,6 +133,34 @@ func ResourceCiPolicy() *schema.Resource {
 							ValidateFunc: validation.StringInSlice([]string{"INFO", "WARN", "FATAL"}, false),
 						},
 						"enforcement_option": enforcementOptionSchema,
+						"rule_exception": {
+							Description: "Dockerfile scan rules that are ignored or always fail this policy, regardless of their severity",
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"rule_id": {
+										Description:  "The id of the dockerfile scan rule, e.g. CIS-DI-0009",
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringMatch(dockerfileScanRuleIdRegex, "must be a dockerfile scan rule id, e.g. CIS-DI-0009"),
+									},
+									"action": {
+										Description:  "IGNORE to never fail this policy on the rule, FAIL to always fail it",
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{model2.CiDockerfileScanRuleExceptionActionIGNORE, model2.CiDockerfileScanRuleExceptionActionFAIL}, false),
+									},
+									"image_name_pattern": {
+										Description:  "A glob pattern of the names of the images the exception applies to, e.g. myrepo/*, all images when empty",
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "",
+										ValidateFunc: validateGlobPattern,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -88,19 +185,15 @@ func resourceCiPolicyCreate(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.FromErr(err)
 	}
 
-	params := model2.PostCiPolicyParams{
-		Body:    ciPolicy,
-		Context: ctx,
-	}
-
-	ciPolicyCreated, err := serviceApi.PostCiPolicy(&params)
+	ciPolicyCreated := &model2.CiPolicy{}
+	err = serviceApi.CiPolicies().Create(ctx, httpClientWrapper.HttpClient, ciPolicy, ciPolicyCreated)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.SetId(string(ciPolicyCreated.Payload.ID))
+	d.SetId(string(ciPolicyCreated.ID))
 
-	return nil
+	return resourceCiPolicyRead(ctx, d, m)
 }
 
 func resourceCiPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -110,17 +203,16 @@ func resourceCiPolicyRead(ctx context.Context, d *schema.ResourceData, m interfa
 
 	serviceApi := utils2.GetServiceApi(&httpClientWrapper)
 
-	params := model2.GetCiPolicyParams{
-		Context: ctx,
-	}
-
-	ciPolicy, err := serviceApi.GetCiPolicy(&params)
+	var ciPolicies []*model2.CiPolicy
+	err := serviceApi.CiPolicies().List(ctx, httpClientWrapper.HttpClient, &ciPolicies)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	if string(ciPolicy.Payload.ID) == d.Id() {
-		return diag.FromErr(updateCiPolicyMutableFields(d, ciPolicy.Payload))
+	for _, ciPolicy := range ciPolicies {
+		if string(ciPolicy.ID) == d.Id() {
+			return diag.FromErr(updateCiPolicyMutableFields(d, ciPolicy))
+		}
 	}
 
 	// Tell terraform the ci policy doesn't exist
@@ -130,7 +222,67 @@ func resourceCiPolicyRead(ctx context.Context, d *schema.ResourceData, m interfa
 }
 
 func updateCiPolicyMutableFields(d *schema.ResourceData, policy *model2.CiPolicy) error {
-	return nil
+	log.Print("[DEBUG] updating ci policy mutable fields")
+
+	_ = d.Set(nameFieldName, policy.Name)
+	_ = d.Set(descriptionFieldName, policy.Description)
+
+	vulnerabilityPolicy := make([]interface{}, 0)
+	if policy.VulnerabilityCiPolicy != nil {
+		allowlist := make([]interface{}, 0)
+		for _, entry := range policy.VulnerabilityCiPolicy.VulnerabilityAllowlist {
+			expirationDate := ""
+			if entry.ExpirationDate != nil {
+				expirationDate = time.Time(*entry.ExpirationDate).Format(ciPolicyDateFormat)
+			}
+			allowlist = append(allowlist, map[string]interface{}{
+				"vulnerability":   entry.VulnerabilityName,
+				"package_name":    entry.PackageName,
+				"expiration_date": expirationDate,
+			})
+		}
+
+		gracePeriods := make([]interface{}, 0)
+		for _, gracePeriod := range policy.VulnerabilityCiPolicy.GracePeriods {
+			gracePeriods = append(gracePeriods, map[string]interface{}{
+				"severity": string(gracePeriod.Severity),
+				"days":     int(gracePeriod.Days),
+			})
+		}
+
+		vulnerabilityPolicy = append(vulnerabilityPolicy, map[string]interface{}{
+			"permissible_vulnerability_level": string(policy.VulnerabilityCiPolicy.PermissibleVulnerabilityLevel),
+			"enforcement_option":              string(policy.VulnerabilityCiPolicy.EnforcementOption),
+			"fail_only_if_fix_available":      policy.VulnerabilityCiPolicy.FailOnlyIfFixAvailable,
+			"allowlist":                       allowlist,
+			"grace_period":                    gracePeriods,
+		})
+	}
+
+	err := d.Set("vulnerability_policy", vulnerabilityPolicy)
+	if err != nil {
+		return err
+	}
+
+	dockerfileScanPolicy := make([]interface{}, 0)
+	if policy.DockerfileScanCiPolicy != nil {
+		ruleExceptions := make([]interface{}, 0)
+		for _, ruleException := range policy.DockerfileScanCiPolicy.RuleExceptions {
+			ruleExceptions = append(ruleExceptions, map[string]interface{}{
+				"rule_id":            ruleException.RuleID,
+				"action":             ruleException.Action,
+				"image_name_pattern": ruleException.ImageNamePattern,
+			})
+		}
+
+		dockerfileScanPolicy = append(dockerfileScanPolicy, map[string]interface{}{
+			"permissible_dockerfile_scan_severity": string(policy.DockerfileScanCiPolicy.PermissibleDockerfileScanSeverity),
+			"enforcement_option":                   string(policy.DockerfileScanCiPolicy.EnforcementOption),
+			"rule_exception":                       ruleExceptions,
+		})
+	}
+
+	return d.Set("dockerfile_scan_policy", dockerfileScanPolicy)
 }
 
 func resourceCiPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -150,18 +302,13 @@ func resourceCiPolicyUpdate(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.FromErr(err)
 	}
 
-	params := model2.PutCiPolicyPolicyIDParams{
-		PolicyID: strfmt.UUID(d.Id()),
-		Body:     ciPolicy,
-		Context:  ctx,
-	}
-
-	ciPolicyUpdated, err := serviceApi.PutCiPolicyPolicyID(&params)
+	ciPolicyUpdated := &model2.CiPolicy{}
+	err = serviceApi.CiPolicies().Update(ctx, httpClientWrapper.HttpClient, strfmt.UUID(d.Id()), ciPolicy, ciPolicyUpdated)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.SetId(string(ciPolicyUpdated.Payload.ID))
+	d.SetId(string(ciPolicyUpdated.ID))
 
 	return resourceCiPolicyRead(ctx, d, m)
 }
@@ -173,12 +320,7 @@ func resourceCiPolicyDelete(ctx context.Context, d *schema.ResourceData, m inter
 
 	serviceApi := utils2.GetServiceApi(&httpClientWrapper)
 
-	params := model2.DeleteCiPolicyPolicyIDParams{
-		PolicyID: strfmt.UUID(d.Id()),
-		Context:  ctx,
-	}
-
-	_, err := serviceApi.DeleteCiPolicyPolicyID(&params)
+	err := serviceApi.CiPolicies().Delete(ctx, httpClientWrapper.HttpClient, strfmt.UUID(d.Id()))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -192,9 +334,74 @@ func resourceCiPolicyDelete(ctx context.Context, d *schema.ResourceData, m inter
 func validateCiPolicyConfig(d *schema.ResourceData) error {
 	log.Printf("[DEBUG] validating ci policy config")
 
+	for _, entry := range getNestedSetFromTf(d, "vulnerability_policy", "allowlist") {
+		entryMap := entry.(map[string]interface{})
+		if entryMap["vulnerability"] == "" && entryMap["package_name"] == "" {
+			return fmt.Errorf("an allowlist entry must set vulnerability, package_name or both")
+		}
+	}
+
+	severities := map[string]bool{}
+	for _, gracePeriod := range getNestedSetFromTf(d, "vulnerability_policy", "grace_period") {
+		severity := gracePeriod.(map[string]interface{})["severity"].(string)
+		if severities[severity] {
+			return fmt.Errorf("a grace period of severity %s is defined more than once", severity)
+		}
+		severities[severity] = true
+	}
+
+	// a rule can't be both ignored and failed on for the same images
+	ruleScopes := map[string]bool{}
+	for _, ruleException := range getNestedSetFromTf(d, "dockerfile_scan_policy", "rule_exception") {
+		ruleExceptionMap := ruleException.(map[string]interface{})
+		ruleScope := ruleExceptionMap["rule_id"].(string) + " " + ruleExceptionMap["image_name_pattern"].(string)
+		if ruleScopes[ruleScope] {
+			return fmt.Errorf("rule %s has more than one exception for image name pattern '%s'", ruleExceptionMap["rule_id"], ruleExceptionMap["image_name_pattern"])
+		}
+		ruleScopes[ruleScope] = true
+	}
+
 	return nil
 }
 
+func getNestedSetFromTf(d *schema.ResourceData, blockName string, setName string) []interface{} {
+	if len(d.Get(blockName).([]interface{})) == 0 {
+		return nil
+	}
+
+	return d.Get(blockName + ".0." + setName).(*schema.Set).List()
+}
+
+func validateGlobPattern(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	if _, err := path.Match(v, ""); err != nil {
+		return nil, []error{fmt.Errorf("expected %s to be a glob pattern, got %s: %s", k, v, err)}
+	}
+
+	return nil, nil
+}
+
+func validateDate(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	if v == "" {
+		return nil, nil
+	}
+
+	if _, err := time.Parse(ciPolicyDateFormat, v); err != nil {
+		return nil, []error{fmt.Errorf("expected %s to be a date in the YYYY-MM-DD format, got %s", k, v)}
+	}
+
+	return nil, nil
+}
+
 func getCiPolicyFromConfig(d *schema.ResourceData, api *escherClient.MgmtServiceApiCtx) (*model2.CiPolicy, error) {
 	log.Print("[DEBUG] getting ci policy from config")
 
@@ -210,9 +417,17 @@ func getCiPolicyFromConfig(d *schema.ResourceData, api *escherClient.MgmtService
 	enforcementOption := utils2.ReadNestedStringFromTF(d, "vulnerability_policy", "enforcement_option", 0)
 
 	if permissibleVulnerabilityLevel != "" && enforcementOption != "" {
+		allowlist, err := getCiVulnerabilityAllowlistFromConfig(d)
+		if err != nil {
+			return nil, err
+		}
+
 		ciPolicy.VulnerabilityCiPolicy = &model2.CiVulnerabilityPolicy{
 			PermissibleVulnerabilityLevel: model2.VulnerabilitySeverity(permissibleVulnerabilityLevel),
 			EnforcementOption:             model2.EnforcementOption(enforcementOption),
+			FailOnlyIfFixAvailable:        utils2.ReadNestedBoolFromTF(d, "vulnerability_policy", "fail_only_if_fix_available", 0),
+			VulnerabilityAllowlist:        allowlist,
+			GracePeriods:                  getCiVulnerabilityGracePeriodsFromConfig(d),
 		}
 	}
 
@@ -223,8 +438,61 @@ func getCiPolicyFromConfig(d *schema.ResourceData, api *escherClient.MgmtService
 		ciPolicy.DockerfileScanCiPolicy = &model2.CiDockerfileScanPolicy{
 			PermissibleDockerfileScanSeverity: model2.DockerfileScanSeverity(permissibleVulnerabilityLevel),
 			EnforcementOption:                 model2.EnforcementOption(enforcementOption),
+			RuleExceptions:                    getCiDockerfileScanRuleExceptionsFromConfig(d),
 		}
 	}
 
 	return ciPolicy, nil
 }
+
+func getCiVulnerabilityAllowlistFromConfig(d *schema.ResourceData) ([]*model2.CiVulnerabilityAllowlistEntry, error) {
+	allowlist := make([]*model2.CiVulnerabilityAllowlistEntry, 0)
+	for _, entry := range getNestedSetFromTf(d, "vulnerability_policy", "allowlist") {
+		entryMap := entry.(map[string]interface{})
+
+		allowlistEntry := &model2.CiVulnerabilityAllowlistEntry{
+			VulnerabilityName: entryMap["vulnerability"].(string),
+			PackageName:       entryMap["package_name"].(string),
+		}
+
+		if expirationDate := entryMap["expiration_date"].(string); expirationDate != "" {
+			date, err := time.Parse(ciPolicyDateFormat, expirationDate)
+			if err != nil {
+				return nil, err
+			}
+			strfmtDate := strfmt.Date(date)
+			allowlistEntry.ExpirationDate = &strfmtDate
+		}
+
+		allowlist = append(allowlist, allowlistEntry)
+	}
+
+	return allowlist, nil
+}
+
+func getCiVulnerabilityGracePeriodsFromConfig(d *schema.ResourceData) []*model2.CiVulnerabilityGracePeriod {
+	gracePeriods := make([]*model2.CiVulnerabilityGracePeriod, 0)
+	for _, gracePeriod := range getNestedSetFromTf(d, "vulnerability_policy", "grace_period") {
+		gracePeriodMap := gracePeriod.(map[string]interface{})
+		gracePeriods = append(gracePeriods, &model2.CiVulnerabilityGracePeriod{
+			Severity: model2.VulnerabilitySeverity(gracePeriodMap["severity"].(string)),
+			Days:     int64(gracePeriodMap["days"].(int)),
+		})
+	}
+
+	return gracePeriods
+}
+
+func getCiDockerfileScanRuleExceptionsFromConfig(d *schema.ResourceData) []*model2.CiDockerfileScanRuleException {
+	ruleExceptions := make([]*model2.CiDockerfileScanRuleException, 0)
+	for _, ruleException := range getNestedSetFromTf(d, "dockerfile_scan_policy", "rule_exception") {
+		ruleExceptionMap := ruleException.(map[string]interface{})
+		ruleExceptions = append(ruleExceptions, &model2.CiDockerfileScanRuleException{
+			RuleID:           ruleExceptionMap["rule_id"].(string),
+			Action:           ruleExceptionMap["action"].(string),
+			ImageNamePattern: ruleExceptionMap["image_name_pattern"].(string),
+		})
+	}
+
+	return ruleExceptions
+}
@@ -0,0 +1,224 @@
+package securecn
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	model2 "terraform-provider-securecn/internal/escher_api/model"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func getTestCiPolicyResourceData(t *testing.T, policyId string, vulnerabilityPolicy map[string]interface{}) *schema.ResourceData {
+	d := schema.TestResourceDataRaw(t, ResourceCiPolicy().Schema, map[string]interface{}{
+		nameFieldName:          "policy",
+		"vulnerability_policy": []interface{}{vulnerabilityPolicy},
+	})
+	d.SetId(policyId)
+
+	return d
+}
+
+func TestCiPolicyReadsBackVulnerabilityControls(t *testing.T) {
+	name := "policy"
+	expirationDate := strfmt.Date(time.Date(2030, 1, 31, 0, 0, 0, 0, time.UTC))
+	otherName := "other"
+	api := newFakeSecureCNApi(t)
+	api.addModel(fakeApiCiPolicies, &model2.CiPolicy{Name: &otherName})
+	policyId := api.addModel(fakeApiCiPolicies, &model2.CiPolicy{
+		Name: &name,
+		VulnerabilityCiPolicy: &model2.CiVulnerabilityPolicy{
+			PermissibleVulnerabilityLevel: "HIGH",
+			EnforcementOption:             "FAIL",
+			FailOnlyIfFixAvailable:        true,
+			VulnerabilityAllowlist: []*model2.CiVulnerabilityAllowlistEntry{
+				{PackageName: "openssl"},
+				{VulnerabilityName: "CVE-2021-44228", PackageName: "log4j", ExpirationDate: &expirationDate},
+			},
+			GracePeriods: []*model2.CiVulnerabilityGracePeriod{{Severity: "CRITICAL", Days: 7}},
+		},
+	})
+
+	d := getTestCiPolicyResourceData(t, policyId, map[string]interface{}{
+		"permissible_vulnerability_level": "HIGH",
+		"enforcement_option":              "FAIL",
+		"fail_only_if_fix_available":      true,
+		"allowlist": []interface{}{
+			map[string]interface{}{"vulnerability": "CVE-2021-44228", "package_name": "log4j", "expiration_date": "2030-01-31"},
+			map[string]interface{}{"package_name": "openssl"},
+		},
+		"grace_period": []interface{}{
+			map[string]interface{}{"severity": "CRITICAL", "days": 7},
+		},
+	})
+	configured := d.Get("vulnerability_policy.0.allowlist").(*schema.Set)
+
+	diags := resourceCiPolicyRead(context.Background(), d, api.client())
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+
+	if d.Id() != policyId {
+		t.Fatal("expected the policy to be found")
+	}
+	read := d.Get("vulnerability_policy.0.allowlist").(*schema.Set)
+	if !read.Equal(configured) {
+		t.Fatalf("expected no diff, configured: %v, read: %v", configured.List(), read.List())
+	}
+	if d.Get("vulnerability_policy.0.grace_period").(*schema.Set).Len() != 1 || !d.Get("vulnerability_policy.0.fail_only_if_fix_available").(bool) {
+		t.Fatalf("unexpected vulnerability policy: %v", d.Get("vulnerability_policy"))
+	}
+}
+
+func TestCiPolicyUpdateSendsVulnerabilityControls(t *testing.T) {
+	name := "policy"
+	api := newFakeSecureCNApi(t)
+	policyId := api.addModel(fakeApiCiPolicies, &model2.CiPolicy{Name: &name})
+
+	d := getTestCiPolicyResourceData(t, policyId, map[string]interface{}{
+		"permissible_vulnerability_level": "MEDIUM",
+		"enforcement_option":              "WARN",
+		"allowlist": []interface{}{
+			map[string]interface{}{"vulnerability": "CVE-2022-0001", "expiration_date": "2031-12-01"},
+		},
+		"grace_period": []interface{}{
+			map[string]interface{}{"severity": "HIGH", "days": 30},
+		},
+	})
+
+	diags := resourceCiPolicyUpdate(context.Background(), d, api.client())
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+
+	policy := &model2.CiPolicy{}
+	api.decodeObject(fakeApiCiPolicies, policyId, policy)
+	sent := policy.VulnerabilityCiPolicy
+	if sent == nil || len(sent.VulnerabilityAllowlist) != 1 || len(sent.GracePeriods) != 1 {
+		t.Fatalf("unexpected vulnerability policy sent: %+v", sent)
+	}
+	if sent.VulnerabilityAllowlist[0].ExpirationDate.String() != "2031-12-01" || sent.GracePeriods[0].Days != 30 {
+		t.Fatalf("unexpected vulnerability policy sent: %+v", sent)
+	}
+}
+
+func TestCiPolicyRejectsEmptyAllowlistEntry(t *testing.T) {
+	d := getTestCiPolicyResourceData(t, "", map[string]interface{}{
+		"permissible_vulnerability_level": "MEDIUM",
+		"enforcement_option":              "WARN",
+		"allowlist": []interface{}{
+			map[string]interface{}{"expiration_date": "2031-12-01"},
+		},
+	})
+
+	if err := validateCiPolicyConfig(d); err == nil {
+		t.Fatal("expected an allowlist entry without vulnerability and package_name to be rejected")
+	}
+}
+
+func TestCiPolicyReadDetectsDockerfileRuleExceptionDrift(t *testing.T) {
+	name := "policy"
+	api := newFakeSecureCNApi(t)
+	policyId := api.addModel(fakeApiCiPolicies, &model2.CiPolicy{
+		Name: &name,
+		DockerfileScanCiPolicy: &model2.CiDockerfileScanPolicy{
+			PermissibleDockerfileScanSeverity: "WARN",
+			EnforcementOption:                 "FAIL",
+			RuleExceptions: []*model2.CiDockerfileScanRuleException{
+				{RuleID: "CIS-DI-0005", Action: "IGNORE", ImageNamePattern: "myrepo/*"},
+			},
+		},
+	})
+
+	d := schema.TestResourceDataRaw(t, ResourceCiPolicy().Schema, map[string]interface{}{
+		nameFieldName: "policy",
+		"dockerfile_scan_policy": []interface{}{
+			map[string]interface{}{
+				"permissible_dockerfile_scan_severity": "WARN",
+				"enforcement_option":                   "FAIL",
+				"rule_exception": []interface{}{
+					map[string]interface{}{"rule_id": "CIS-DI-0005", "action": "IGNORE", "image_name_pattern": "myrepo/*"},
+					map[string]interface{}{"rule_id": "CIS-DI-0001", "action": "FAIL"},
+				},
+			},
+		},
+	})
+	d.SetId(policyId)
+
+	diags := resourceCiPolicyRead(context.Background(), d, api.client())
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+
+	read := d.Get("dockerfile_scan_policy.0.rule_exception").(*schema.Set).List()
+	if len(read) != 1 || read[0].(map[string]interface{})["rule_id"] != "CIS-DI-0005" {
+		t.Fatalf("expected the rule exception removed on the server side to be detected, read: %v", read)
+	}
+}
+
+func TestCiPolicyRejectsConflictingDockerfileRuleExceptions(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceCiPolicy().Schema, map[string]interface{}{
+		nameFieldName: "policy",
+		"dockerfile_scan_policy": []interface{}{
+			map[string]interface{}{
+				"permissible_dockerfile_scan_severity": "WARN",
+				"enforcement_option":                   "FAIL",
+				"rule_exception": []interface{}{
+					map[string]interface{}{"rule_id": "CIS-DI-0005", "action": "IGNORE"},
+					map[string]interface{}{"rule_id": "CIS-DI-0005", "action": "FAIL"},
+				},
+			},
+		},
+	})
+
+	if err := validateCiPolicyConfig(d); err == nil {
+		t.Fatal("expected a rule both ignored and failed on for all images to be rejected")
+	}
+}
+
+func TestCiPolicyLifecycle(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	lifecycle := newTestResourceLifecycle(ResourceCiPolicy(), api.client())
+
+	state := lifecycle.apply(t, map[string]interface{}{
+		nameFieldName: "policy",
+		"vulnerability_policy": []interface{}{
+			map[string]interface{}{"permissible_vulnerability_level": "HIGH", "enforcement_option": "FAIL"},
+		},
+	})
+	if policy := api.object(fakeApiCiPolicies, state.ID); policy["name"] != "policy" {
+		t.Fatalf("unexpected ci policy created: %v", policy)
+	}
+
+	updatedState := lifecycle.apply(t, map[string]interface{}{
+		nameFieldName:        "policy",
+		descriptionFieldName: "vulnerabilities and dockerfiles",
+		"vulnerability_policy": []interface{}{
+			map[string]interface{}{
+				"permissible_vulnerability_level": "MEDIUM",
+				"enforcement_option":              "IGNORE",
+				"allowlist":                       []interface{}{map[string]interface{}{"vulnerability": "CVE-2021-44228", "expiration_date": "2030-01-01"}},
+				"grace_period":                    []interface{}{map[string]interface{}{"severity": "CRITICAL", "days": 7}},
+			},
+		},
+		"dockerfile_scan_policy": []interface{}{
+			map[string]interface{}{
+				"permissible_dockerfile_scan_severity": "WARN",
+				"enforcement_option":                   "FAIL",
+				"rule_exception":                       []interface{}{map[string]interface{}{"rule_id": "CIS-DI-0009", "action": "IGNORE"}},
+			},
+		},
+	})
+	if policy := api.object(fakeApiCiPolicies, state.ID); updatedState.ID != state.ID || policy["description"] != "vulnerabilities and dockerfiles" {
+		t.Fatalf("expected the ci policy to be updated in place, got %s: %v", updatedState.ID, policy)
+	}
+
+	lifecycle.importState(t)
+
+	lifecycle.destroy(t)
+	if ids := api.objectIds(fakeApiCiPolicies); len(ids) != 0 {
+		t.Fatalf("expected the ci policy to be deleted, got %v", ids)
+	}
+}
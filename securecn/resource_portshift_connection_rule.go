@@ -10,6 +10,7 @@ import (
 	"regexp"
 	"strings"
 	"terraform-provider-securecn/internal/client"
+	"terraform-provider-securecn/internal/escher_api/escherClient"
 	model2 "terraform-provider-securecn/internal/escher_api/model"
 	utils2 "terraform-provider-securecn/internal/utils"
 
@@ -51,6 +52,9 @@ func ResourceConnectionRule() *schema.Resource {
 		ReadContext:   resourceConnectionRuleRead,
 		UpdateContext: resourceConnectionRuleUpdate,
 		DeleteContext: resourceConnectionRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Description:   "A SecureCN k8s connection rule",
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
@@ -362,12 +366,13 @@ func resourceConnectionRuleCreate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	rule, err := serviceApi.CreateConnectionRule(ctx, httpClientWrapper.HttpClient, ruleConfig)
+	rule := &model2.CdConnectionRule{}
+	err = serviceApi.ConnectionRules().Create(ctx, httpClientWrapper.HttpClient, ruleConfig, rule)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	ruleId := rule.Payload.ID
+	ruleId := rule.ID
 
 	d.SetId(string(ruleId))
 
@@ -382,16 +387,17 @@ func resourceConnectionRuleRead(ctx context.Context, d *schema.ResourceData, m i
 	serviceApi := utils2.GetServiceApi(&httpClientWrapper)
 	ruleId := d.Id()
 
-	currentRuleInSecureCN, err := serviceApi.GetCdConnectionsRule(ctx, httpClientWrapper.HttpClient, strfmt.UUID(ruleId))
-	if err != nil {
+	currentRuleInSecureCN := &model2.CdConnectionRule{}
+	err := serviceApi.ConnectionRules().Get(ctx, httpClientWrapper.HttpClient, strfmt.UUID(ruleId), currentRuleInSecureCN)
+	if err != nil && !escherClient.IsNotFound(err) {
 		return diag.FromErr(err)
 	}
 
-	if currentRuleInSecureCN.Payload.ID == "" || currentRuleInSecureCN.Payload.Status == "DELETED" {
+	if escherClient.IsNotFound(err) || currentRuleInSecureCN.ID == "" || currentRuleInSecureCN.Status == "DELETED" {
 		// Tell terraform the rule doesn't exist
 		d.SetId("")
 	} else {
-		updateConnectionRuleMutableFields(d, currentRuleInSecureCN.Payload)
+		updateConnectionRuleMutableFields(d, currentRuleInSecureCN)
 	}
 
 	return nil
@@ -420,12 +426,13 @@ func resourceConnectionRuleUpdate(ctx context.Context, d *schema.ResourceData, m
 	//	return err
 	//}
 
-	updatedRule, err := serviceApi.UpdateCdConnectionsRule(ctx, httpClientWrapper.HttpClient, rule, rule.ID)
+	updatedRule := &model2.CdConnectionRule{}
+	err = serviceApi.ConnectionRules().Update(ctx, httpClientWrapper.HttpClient, rule.ID, rule, updatedRule)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.SetId(string(updatedRule.Payload.ID))
+	d.SetId(string(updatedRule.ID))
 
 	return resourceConnectionRuleRead(ctx, d, m)
 }
@@ -447,7 +454,7 @@ func resourceConnectionRuleDelete(ctx context.Context, d *schema.ResourceData, m
 	serviceApi := utils2.GetServiceApi(&httpClientWrapper)
 	ruleId := strfmt.UUID(d.Id())
 
-	err := serviceApi.DeleteCdConnectionsRule(ctx, httpClientWrapper.HttpClient, ruleId)
+	err := serviceApi.ConnectionRules().Delete(ctx, httpClientWrapper.HttpClient, ruleId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -690,11 +697,9 @@ func mutateDestination(d *schema.ResourceData, currentRule *model2.CdConnectionR
 		_ = d.Set(destinationPodAnyFieldName, nil)
 	} else if destinationPartType == "PodAnyConnectionRulePart" {
 		currentDestinationInSecureCN := destination.(*model2.PodAnyConnectionRulePart)
-		_ = d.Set(sourcePodAnyFieldName, []map[string]string{{
+		_ = d.Set(destinationPodAnyFieldName, []interface{}{map[string]interface{}{
 			connectionRuleVulnerabilitySeverityFieldName: currentDestinationInSecureCN.VulnerabilitySeverityLevel,
-		}})
-		_ = d.Set(sourcePodAnyFieldName, []map[string][]string{{
-			connectionRuleEnvironmentFieldName: currentDestinationInSecureCN.Environments,
+			connectionRuleEnvironmentFieldName:           currentDestinationInSecureCN.Environments,
 		}})
 		_ = d.Set(destinationAddressIpRangeFieldName, nil)
 		_ = d.Set(destinationAddressDomainFieldName, nil)
@@ -718,7 +723,7 @@ func mutateDestination(d *schema.ResourceData, currentRule *model2.CdConnectionR
 		_ = d.Set(destinationPodLabelFieldName, nil)
 		_ = d.Set(destinationPodAnyFieldName, nil)
 	} else if destinationPartType == "ExternalConnectionRulePart" {
-		_ = d.Set(destinationExternalFieldName, destination)
+		_ = d.Set(destinationExternalFieldName, true)
 		_ = d.Set(destinationAddressIpRangeFieldName, nil)
 		_ = d.Set(destinationAddressDomainFieldName, nil)
 		_ = d.Set(destinationPodNameFieldName, nil)
@@ -747,8 +752,9 @@ func mutateSource(d *schema.ResourceData, currentRuleInSecureCN *model2.CdConnec
 		_ = d.Set(sourcePodAnyFieldName, nil)
 	} else if currentSourcePartTypeInSecureCN == "PodAnyConnectionRulePart" {
 		currentSourceInSecureCN := source.(*model2.PodAnyConnectionRulePart)
-		_ = d.Set(sourcePodAnyFieldName, []map[string]string{{
+		_ = d.Set(sourcePodAnyFieldName, []interface{}{map[string]interface{}{
 			connectionRuleVulnerabilitySeverityFieldName: currentSourceInSecureCN.VulnerabilitySeverityLevel,
+			connectionRuleEnvironmentFieldName:           currentSourceInSecureCN.Environments,
 		}})
 		_ = d.Set(sourcePodLabelFieldName, nil)
 		_ = d.Set(sourcePodNameFieldName, nil)
@@ -762,8 +768,7 @@ func mutateSource(d *schema.ResourceData, currentRuleInSecureCN *model2.CdConnec
 		_ = d.Set(sourceExternalFieldName, nil)
 		_ = d.Set(sourcePodAnyFieldName, nil)
 	} else if currentSourcePartTypeInSecureCN == "ExternalConnectionRulePart" {
-		currentSourceInSecureCN := source.(*model2.ExternalConnectionRulePart)
-		_ = d.Set(sourceExternalFieldName, currentSourceInSecureCN)
+		_ = d.Set(sourceExternalFieldName, true)
 		_ = d.Set(sourceIpRangeFieldName, nil)
 		_ = d.Set(sourcePodLabelFieldName, nil)
 		_ = d.Set(sourcePodNameFieldName, nil)
@@ -775,7 +780,11 @@ func updateByPodNames(d *schema.ResourceData, part model2.ConnectionRulePart, ma
 	currentPartInSecureCN := part.(*model2.PodNameConnectionRulePart)
 	currentPartInTerraform := d.Get(mainField)
 	if currentPartInTerraform == nil || len(currentPartInTerraform.([]interface{})) == 0 {
-		_ = d.Set(mainField, currentPartInSecureCN)
+		_ = d.Set(mainField, []interface{}{map[string]interface{}{
+			connectionRuleNamesFieldName:                 currentPartInSecureCN.Names,
+			connectionRuleVulnerabilitySeverityFieldName: currentPartInSecureCN.VulnerabilitySeverityLevel,
+			connectionRuleEnvironmentFieldName:           currentPartInSecureCN.Environments,
+		}})
 	} else {
 		terraformPart := currentPartInTerraform.([]interface{})[0]
 		for key, value := range terraformPart.(map[string]interface{}) {
@@ -796,7 +805,11 @@ func updateByLabels(d *schema.ResourceData, part model2.ConnectionRulePart, main
 	currentPartInSecureCN := part.(*model2.PodLablesConnectionRulePart)
 	currentPartInTerraform := d.Get(mainField)
 	if currentPartInTerraform == nil || len(currentPartInTerraform.([]interface{})) == 0 {
-		_ = d.Set(mainField, currentPartInSecureCN)
+		_ = d.Set(mainField, []interface{}{map[string]interface{}{
+			connectionRuleNamesLabelsFieldName:           getLabelsInTf(currentPartInSecureCN.Labels),
+			connectionRuleVulnerabilitySeverityFieldName: currentPartInSecureCN.VulnerabilitySeverityLevel,
+			connectionRuleEnvironmentFieldName:           currentPartInSecureCN.Environments,
+		}})
 	} else {
 		terraformPart := currentPartInTerraform.([]interface{})[0]
 
@@ -818,7 +831,9 @@ func updateByIps(d *schema.ResourceData, part model2.ConnectionRulePart, mainFie
 	currentPartInSecureCN := part.(*model2.IPRangeConnectionRulePart)
 	currentPartInTerraform := d.Get(mainField)
 	if currentPartInTerraform == nil || len(currentPartInTerraform.([]interface{})) == 0 {
-		_ = d.Set(mainField, currentPartInSecureCN)
+		_ = d.Set(mainField, []interface{}{map[string]interface{}{
+			ipsFieldName: currentPartInSecureCN.Networks,
+		}})
 	} else {
 		terraformPart := currentPartInTerraform.([]interface{})[0]
 		for key := range terraformPart.(map[string]interface{}) {
@@ -833,7 +848,9 @@ func updateByDomains(d *schema.ResourceData, part model2.ConnectionRulePart, mai
 	currentPartInSecureCN := part.(*model2.FqdnConnectionRulePart)
 	currentPartInTerraform := d.Get(mainField)
 	if currentPartInTerraform == nil || len(currentPartInTerraform.([]interface{})) == 0 {
-		_ = d.Set(mainField, currentPartInSecureCN)
+		_ = d.Set(mainField, []interface{}{map[string]interface{}{
+			domainsFieldName: currentPartInSecureCN.FqdnAddresses,
+		}})
 	} else {
 		terraformPart := currentPartInTerraform.([]interface{})[0]
 		for key := range terraformPart.(map[string]interface{}) {
@@ -866,11 +883,21 @@ func updateStringSliceSubField(d *schema.ResourceData, mainField string, subFiel
 }
 
 func updateLabelMapSubField(d *schema.ResourceData, mainField string, subField string, terraformPart interface{}, secureCNPart []*model2.Label) {
+	labelsInTerraform := terraformPart.(map[string]interface{})[subField]
+	labelsInSecureCN := getLabelsInTf(secureCNPart)
+	if !reflect.DeepEqual(labelsInTerraform, labelsInSecureCN) {
+		fieldInTerraform := terraformPart.(map[string]interface{})
+		fieldInTerraform[subField] = labelsInSecureCN
+		_ = d.Set(mainField, []interface{}{fieldInTerraform})
+	}
+}
 
-	labelsInTerraform := getDataInTerraformAsLabelsSlice(terraformPart, subField)
-	if !reflect.DeepEqual(labelsInTerraform, secureCNPart) {
-		_ = d.Set(mainField, nil)
+func getLabelsInTf(labels []*model2.Label) map[string]interface{} {
+	labelsInTf := make(map[string]interface{}, len(labels))
+	for _, label := range labels {
+		labelsInTf[label.Key] = label.Value
 	}
+	return labelsInTf
 }
 
 func getDataInTerraformAsStringSlice(inter interface{}, subfield string) []string {
@@ -882,18 +909,3 @@ func getDataInTerraformAsStringSlice(inter interface{}, subfield string) []strin
 	}
 	return values
 }
-
-func getDataInTerraformAsLabelsSlice(inter interface{}, subfield string) []*model2.Label {
-	imap := inter.(map[string]interface{})
-	sub := imap[subfield].(map[string]interface{})
-	values := make([]*model2.Label, 0, len(sub))
-	for k, v := range sub {
-		label := &model2.Label{
-			Key:   k,
-			Value: v.(string),
-		}
-
-		values = append(values, label)
-	}
-	return values
-}
@@ -0,0 +1,46 @@
+package securecn
+
+import (
+	"testing"
+)
+
+func TestConnectionRuleLifecycle(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	lifecycle := newTestResourceLifecycle(ResourceConnectionRule(), api.client())
+
+	state := lifecycle.apply(t, map[string]interface{}{
+		connectionRuleNameFieldName: "frontend to backend",
+		sourcePodNameFieldName: []interface{}{
+			map[string]interface{}{connectionRuleNamesFieldName: []interface{}{"frontend"}},
+		},
+		destinationPodNameFieldName: []interface{}{
+			map[string]interface{}{connectionRuleNamesFieldName: []interface{}{"backend"}},
+		},
+	})
+	if rule := api.object(fakeApiConnectionRules, state.ID); rule["name"] != "frontend to backend" {
+		t.Fatalf("unexpected connection rule created: %v", rule)
+	}
+
+	updatedState := lifecycle.apply(t, map[string]interface{}{
+		connectionRuleNameFieldName: "frontend to external",
+		sourcePodLabelFieldName: []interface{}{
+			map[string]interface{}{
+				connectionRuleNamesLabelsFieldName:           map[string]interface{}{"app": "frontend"},
+				connectionRuleVulnerabilitySeverityFieldName: "HIGH",
+			},
+		},
+		destinationAddressDomainFieldName: []interface{}{
+			map[string]interface{}{domainsFieldName: []interface{}{"example.com"}},
+		},
+	})
+	if rule := api.object(fakeApiConnectionRules, state.ID); updatedState.ID != state.ID || rule["name"] != "frontend to external" {
+		t.Fatalf("expected the connection rule to be updated in place, got %s: %v", updatedState.ID, rule)
+	}
+
+	lifecycle.importState(t)
+
+	lifecycle.destroy(t)
+	if ids := api.objectIds(fakeApiConnectionRules); len(ids) != 0 {
+		t.Fatalf("expected the connection rule to be deleted, got %v", ids)
+	}
+}
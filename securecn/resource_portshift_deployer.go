@@ -4,17 +4,25 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
+	"strings"
 	"terraform-provider-securecn/internal/client"
 	"terraform-provider-securecn/internal/escher_api/escherClient"
 	model2 "terraform-provider-securecn/internal/escher_api/model"
 	utils2 "terraform-provider-securecn/internal/utils"
+	"time"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// deployerClusterSyncTimeout is how long to wait by default for the service account of an operator deployer to be reported by its cluster
+const deployerClusterSyncTimeout = 5 * time.Minute
+
 func ResourceDeployer() *schema.Resource {
 
 	return &schema.Resource{
@@ -22,6 +30,21 @@ func ResourceDeployer() *schema.Resource {
 		ReadContext:   resourceDeployerRead,
 		UpdateContext: resourceDeployerUpdate,
 		DeleteContext: resourceDeployerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+			// the type of a deployer can't be changed
+			previousOperatorDeployer, operatorDeployer := d.GetChange("operator_deployer")
+			if d.Id() != "" && len(previousOperatorDeployer.([]interface{})) != len(operatorDeployer.([]interface{})) {
+				return d.ForceNew("operator_deployer")
+			}
+			return nil
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(deployerClusterSyncTimeout),
+			Update: schema.DefaultTimeout(deployerClusterSyncTimeout),
+		},
 		Description:   "A SecureCN deployer",
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
@@ -30,10 +53,11 @@ func ResourceDeployer() *schema.Resource {
 				Required: true,
 			},
 			"operator_deployer": {
-				Description: "Create and modify an operator deployer's properties",
-				Optional:    true,
-				Type:        schema.TypeList,
-				MaxItems:    1,
+				Description:  "Create and modify an operator deployer's properties",
+				Optional:     true,
+				Type:         schema.TypeList,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"operator_deployer", "securecn_deployer"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"cluster_id": {
@@ -50,7 +74,7 @@ func ResourceDeployer() *schema.Resource {
 							Type:        schema.TypeString,
 						},
 						"service_account": {
-							Description: "The Kubernetes ServiceAccount name of the deployer",
+							Description: "The Kubernetes ServiceAccount name of the deployer, waited for until the cluster reports it or the create/update timeout expires",
 							Required:    true,
 							Type:        schema.TypeString,
 						},
@@ -63,6 +87,45 @@ func ResourceDeployer() *schema.Resource {
 					},
 				},
 			},
+			"deployer_type": {
+				Description: "The type of the deployer, OperatorDeployer or SecureCnDeployer",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"cluster_name": {
+				Description: "The name of the kubernetes cluster in SecureCN of an operator deployer",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"namespace_id": {
+				Description: "The id of the namespace of the ServiceAccount of an operator deployer",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"service_account_id": {
+				Description: "The id of the Kubernetes ServiceAccount of an operator deployer",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+			"securecn_deployer": {
+				Description:  "Create and modify a SecureCN CD plugin deployer's properties",
+				Optional:     true,
+				Type:         schema.TypeList,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"operator_deployer", "securecn_deployer"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"deployer_id": {
+							Description:  "The id the SecureCN CD plugin identifies this deployer with, generated when empty",
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							Type:         schema.TypeString,
+							ValidateFunc: validation.IsUUID,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -79,19 +142,20 @@ func resourceDeployerCreate(ctx context.Context, d *schema.ResourceData, m inter
 
 	serviceApi := utils2.GetServiceApi(&httpClientWrapper)
 
-	deployerFromConfig, err := getDeployerFromConfig(ctx, d, serviceApi)
+	deployerFromConfig, err := getDeployerFromConfig(ctx, d, serviceApi, httpClientWrapper.HttpClient, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	deployer, err := serviceApi.CreateDeployer(ctx, deployerFromConfig)
+	var deployer model2.Deployer
+	err = serviceApi.Deployers().Create(ctx, httpClientWrapper.HttpClient, deployerFromConfig, &deployer)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.SetId(string(deployer.Payload.ID()))
+	d.SetId(string(deployer.ID()))
 
-	return nil
+	return resourceDeployerRead(ctx, d, m)
 }
 
 func resourceDeployerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -102,23 +166,95 @@ func resourceDeployerRead(ctx context.Context, d *schema.ResourceData, m interfa
 	serviceApi := utils2.GetServiceApi(&httpClientWrapper)
 	deployerId := d.Id()
 
-	deployer, err := serviceApi.GetDeployerById(ctx, strfmt.UUID(deployerId))
+	var deployers []model2.Deployer
+	err := serviceApi.Deployers().List(ctx, httpClientWrapper.HttpClient, &deployers)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	var deployer model2.Deployer
+	for _, existingDeployer := range deployers {
+		if existingDeployer.ID() == strfmt.UUID(deployerId) {
+			deployer = existingDeployer
+			break
+		}
+	}
+
 	if deployer == nil {
 		// Tell terraform the deployer doesn't exist
 		d.SetId("")
 	} else {
-		return diag.FromErr(updateDeployerMutableFields(d, deployer))
+		return diag.FromErr(updateDeployerMutableFields(ctx, d, deployer, serviceApi, httpClientWrapper.HttpClient))
 	}
 
 	return nil
 }
 
-func updateDeployerMutableFields(d *schema.ResourceData, deployer model2.Deployer) error {
-	return nil
+func updateDeployerMutableFields(ctx context.Context, d *schema.ResourceData, deployer model2.Deployer, api *escherClient.MgmtServiceApiCtx, httpClient *http.Client) error {
+	log.Print("[DEBUG] updating deployer mutable fields")
+
+	err := d.Set(nameFieldName, deployer.Deployer())
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("deployer_type", deployer.DeployerType())
+	if err != nil {
+		return err
+	}
+
+	switch typedDeployer := deployer.(type) {
+	case *model2.OperatorDeployer:
+		serviceAccountName, err := getServiceAccountNameById(ctx, api, httpClient, typedDeployer.ClusterID, typedDeployer.Namespace, typedDeployer.DeployerID())
+		if err != nil {
+			return err
+		}
+
+		_ = d.Set("cluster_name", typedDeployer.Cluster)
+		_ = d.Set("namespace_id", string(typedDeployer.NamespaceID))
+		if typedDeployer.DeployerID() != nil {
+			_ = d.Set("service_account_id", string(*typedDeployer.DeployerID()))
+		}
+
+		securityCheck := typedDeployer.SecurityCheck != nil && *typedDeployer.SecurityCheck
+		return d.Set("operator_deployer", []interface{}{map[string]interface{}{
+			"cluster_id":      string(typedDeployer.ClusterID),
+			"namespace":       typedDeployer.Namespace,
+			"service_account": serviceAccountName,
+			"security_check":  securityCheck,
+		}})
+	case *model2.SecureCnDeployer:
+		deployerId := ""
+		if typedDeployer.DeployerID() != nil {
+			deployerId = string(*typedDeployer.DeployerID())
+		}
+
+		return d.Set("securecn_deployer", []interface{}{map[string]interface{}{
+			"deployer_id": deployerId,
+		}})
+	default:
+		return fmt.Errorf("unsupported deployer type %s", deployer.DeployerType())
+	}
+}
+
+func getServiceAccountNameById(ctx context.Context, api *escherClient.MgmtServiceApiCtx, httpClient *http.Client, clusterId strfmt.UUID, namespaceName string, serviceAccountId *strfmt.UUID) (string, error) {
+	if serviceAccountId == nil {
+		return "", nil
+	}
+
+	serviceAccounts, err := api.GetDeployersServiceAccountsByNamespace(ctx, httpClient, clusterId, namespaceName)
+	if err != nil {
+		return "", err
+	}
+
+	for _, serviceAccount := range serviceAccounts {
+		if serviceAccount.ID == *serviceAccountId {
+			return serviceAccount.Name, nil
+		}
+	}
+
+	log.Printf("[WARN] service account %s of the deployer wasn't found on cluster", *serviceAccountId)
+	return "", nil
 }
 
 func resourceDeployerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -133,19 +269,20 @@ func resourceDeployerUpdate(ctx context.Context, d *schema.ResourceData, m inter
 
 	serviceApi := utils2.GetServiceApi(&httpClientWrapper)
 
-	deployer, err := getDeployerFromConfig(ctx, d, serviceApi)
+	deployer, err := getDeployerFromConfig(ctx, d, serviceApi, httpClientWrapper.HttpClient, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	deployer.SetID(strfmt.UUID(d.Id()))
 
-	updatedDeployer, err := serviceApi.UpdateDeployer(ctx, deployer)
+	var updatedDeployer model2.Deployer
+	err = serviceApi.Deployers().Update(ctx, httpClientWrapper.HttpClient, strfmt.UUID(d.Id()), deployer, &updatedDeployer)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.SetId(string(updatedDeployer.Payload.ID()))
+	d.SetId(string(updatedDeployer.ID()))
 
 	return resourceDeployerRead(ctx, d, m)
 }
@@ -156,7 +293,7 @@ func resourceDeployerDelete(ctx context.Context, d *schema.ResourceData, m inter
 	httpClientWrapper := m.(client.HttpClientWrapper)
 
 	serviceApi := utils2.GetServiceApi(&httpClientWrapper)
-	_, err := serviceApi.DeleteDeployer(ctx, strfmt.UUID(d.Id()))
+	err := serviceApi.Deployers().Delete(ctx, httpClientWrapper.HttpClient, strfmt.UUID(d.Id()))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -170,50 +307,85 @@ func resourceDeployerDelete(ctx context.Context, d *schema.ResourceData, m inter
 func validateDeployerConfig(d *schema.ResourceData) error {
 	log.Printf("[DEBUG] validating deployer config")
 
-	if d.Get("operator_deployer") == nil {
-		return fmt.Errorf("currently only operator_deployer is supported and operator_deployer is mandatory")
+	if len(d.Get("operator_deployer").([]interface{})) == 0 && len(d.Get("securecn_deployer").([]interface{})) == 0 {
+		return fmt.Errorf("one of operator_deployer,securecn_deployer is mandatory")
 	}
 
 	return nil
 }
 
-func getDeployerFromConfig(ctx context.Context, d *schema.ResourceData, api *escherClient.MgmtServiceApiCtx) (model2.Deployer, error) {
+func getDeployerFromConfig(ctx context.Context, d *schema.ResourceData, api *escherClient.MgmtServiceApiCtx, httpClient *http.Client, timeout time.Duration) (model2.Deployer, error) {
 	log.Print("[DEBUG] getting deployer from config")
 
+	if len(d.Get("securecn_deployer").([]interface{})) > 0 {
+		return getSecureCnDeployerFromConfig(d), nil
+	}
+
+	return getOperatorDeployerFromConfig(ctx, d, api, httpClient, timeout)
+}
+
+func getSecureCnDeployerFromConfig(d *schema.ResourceData) model2.Deployer {
+	name := d.Get(nameFieldName).(string)
+	deployerId := strfmt.UUID(utils2.ReadNestedStringFromTF(d, "securecn_deployer", "deployer_id", 0))
+	if deployerId == "" {
+		deployerId = strfmt.UUID(uuid.New().String())
+	}
+
+	deployer := &model2.SecureCnDeployer{}
+
+	deployer.SetDeployer(name)
+	deployer.SetDeployerID(&deployerId)
+
+	return deployer
+}
+
+func getOperatorDeployerFromConfig(ctx context.Context, d *schema.ResourceData, api *escherClient.MgmtServiceApiCtx, httpClient *http.Client, timeout time.Duration) (model2.Deployer, error) {
 	name := d.Get(nameFieldName).(string)
 	clusterId := utils2.ReadNestedStringFromTF(d, "operator_deployer", "cluster_id", 0)
 	namespaceName := utils2.ReadNestedStringFromTF(d, "operator_deployer", "namespace", 0)
 	securityCheck := utils2.ReadNestedBoolFromTF(d, "operator_deployer", "security_check", 0)
 	serviceAccountName := utils2.ReadNestedStringFromTF(d, "operator_deployer", "service_account", 0)
 
-	serviceAccounts, err := api.GetDeployersServiceAccountsByNamespace(ctx, strfmt.UUID(clusterId), namespaceName)
-	if err != nil {
-		return nil, err
-	}
-
+	// a newly installed cluster reports its namespaces and service accounts only after it first syncs
+	var namespace *model2.KubernetesNamespaceResponse
 	var serviceAccount *model2.ServiceAccountInfo
-	for _, sai := range serviceAccounts.Payload {
-		if sai.Name == serviceAccountName {
-			serviceAccount = sai
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		namespaces, err := api.GetKubernetesClusterNamespaces(ctx, httpClient, strfmt.UUID(clusterId))
+		if err != nil {
+			return resource.NonRetryableError(err)
 		}
-	}
-	if serviceAccount == nil {
-		return nil, fmt.Errorf("failed to find %s service account on cluster: %+v", serviceAccountName, serviceAccounts.Payload)
-	}
 
-	namespaces, err := api.GetKubernetesClustersKubernetesClusterIDNamespaces(ctx, strfmt.UUID(clusterId))
-	if err != nil {
-		return nil, err
-	}
+		var namespaceNames []string
+		for _, ns := range namespaces {
+			namespaceNames = append(namespaceNames, ns.Name)
+			if ns.Name == namespaceName {
+				namespace = ns
+			}
+		}
+		if namespace == nil {
+			return resource.RetryableError(fmt.Errorf("failed to find %s namespace on cluster %s, found namespaces: [%s]", namespaceName, clusterId, strings.Join(namespaceNames, ", ")))
+		}
 
-	var namespace *model2.KubernetesNamespaceResponse
-	for _, ns := range namespaces.Payload {
-		if ns.Name == namespaceName {
-			namespace = ns
+		serviceAccounts, err := api.GetDeployersServiceAccountsByNamespace(ctx, httpClient, strfmt.UUID(clusterId), namespaceName)
+		if err != nil {
+			return resource.NonRetryableError(err)
 		}
-	}
-	if namespace == nil {
-		return nil, fmt.Errorf("failed to find %s namespace on cluster", namespaceName)
+
+		var serviceAccountNames []string
+		for _, sai := range serviceAccounts {
+			serviceAccountNames = append(serviceAccountNames, sai.Name)
+			if sai.Name == serviceAccountName {
+				serviceAccount = sai
+			}
+		}
+		if serviceAccount == nil {
+			return resource.RetryableError(fmt.Errorf("failed to find %s service account in namespace %s on cluster %s, found service accounts: [%s]", serviceAccountName, namespaceName, clusterId, strings.Join(serviceAccountNames, ", ")))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	deployer := &model2.OperatorDeployer{
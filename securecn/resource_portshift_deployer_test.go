@@ -0,0 +1,129 @@
+package securecn
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	model2 "terraform-provider-securecn/internal/escher_api/model"
+	utils2 "terraform-provider-securecn/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func getTestOperatorDeployerResourceData(t *testing.T, clusterId string) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, ResourceDeployer().Schema, map[string]interface{}{
+		nameFieldName: "deployer",
+		"operator_deployer": []interface{}{
+			map[string]interface{}{
+				"cluster_id":      clusterId,
+				"namespace":       "default",
+				"service_account": "vault",
+			},
+		},
+	})
+}
+
+func TestOperatorDeployerWaitsForClusterSync(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	clusterId := api.addCluster("cluster-a", "default")
+	serviceAccountId := api.addServiceAccount(clusterId, "default", "vault")
+	api.syncNamespacesAfter(clusterId, 1)
+	httpClientWrapper := api.client()
+
+	d := getTestOperatorDeployerResourceData(t, clusterId)
+
+	deployer, err := getDeployerFromConfig(context.Background(), d, utils2.GetServiceApi(&httpClientWrapper), httpClientWrapper.HttpClient, time.Minute)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	operatorDeployer := deployer.(*model2.OperatorDeployer)
+	if operatorDeployer.NamespaceID == "" {
+		t.Fatal("expected the namespace id to be resolved once the cluster synced")
+	}
+	namespaceRequests := 0
+	for _, request := range api.requestLog() {
+		if strings.HasSuffix(request, "/namespaces") {
+			namespaceRequests++
+		}
+	}
+	if namespaceRequests < 2 {
+		t.Fatalf("expected the namespaces to be requested again until the cluster synced, got %d requests", namespaceRequests)
+	}
+	if string(*operatorDeployer.DeployerID()) != serviceAccountId {
+		t.Fatalf("expected service account id %s, got %s", serviceAccountId, *operatorDeployer.DeployerID())
+	}
+}
+
+func TestOperatorDeployerListsFoundServiceAccountsOnTimeout(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	clusterId := api.addCluster("cluster-a", "default")
+	api.addServiceAccount(clusterId, "default", "default")
+	httpClientWrapper := api.client()
+
+	d := getTestOperatorDeployerResourceData(t, clusterId)
+
+	_, err := getDeployerFromConfig(context.Background(), d, utils2.GetServiceApi(&httpClientWrapper), httpClientWrapper.HttpClient, time.Second)
+	if err == nil {
+		t.Fatal("expected a missing service account error")
+	}
+	if !strings.Contains(err.Error(), "failed to find vault service account") || !strings.Contains(err.Error(), "[default]") {
+		t.Fatalf("expected the error to list the found service accounts, got: %s", err)
+	}
+}
+
+func TestOperatorDeployerLifecycle(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	clusterId := api.addCluster("cluster-a", "default")
+	api.addServiceAccount(clusterId, "default", "vault")
+	vaultOperatorId := api.addServiceAccount(clusterId, "default", "vault-operator")
+	lifecycle := newTestResourceLifecycle(ResourceDeployer(), api.client())
+
+	config := map[string]interface{}{
+		nameFieldName: "deployer",
+		"operator_deployer": []interface{}{
+			map[string]interface{}{"cluster_id": clusterId, "namespace": "default", "service_account": "vault"},
+		},
+	}
+	state := lifecycle.apply(t, config)
+	if state.Attributes["cluster_name"] != "cluster-a" || state.Attributes["deployer_type"] != "OperatorDeployer" {
+		t.Fatalf("unexpected deployer state: %v", state.Attributes)
+	}
+
+	config["operator_deployer"] = []interface{}{
+		map[string]interface{}{"cluster_id": clusterId, "namespace": "default", "service_account": "vault-operator", "security_check": true},
+	}
+	updatedState := lifecycle.apply(t, config)
+	if updatedState.ID != state.ID || updatedState.Attributes["service_account_id"] != vaultOperatorId {
+		t.Fatalf("expected the deployer to be updated in place, got %s: %v", updatedState.ID, updatedState.Attributes)
+	}
+
+	lifecycle.importState(t)
+
+	lifecycle.destroy(t)
+	if ids := api.objectIds(fakeApiDeployers); len(ids) != 0 {
+		t.Fatalf("expected the deployer to be deleted, got %v", ids)
+	}
+}
+
+func TestSecureCNDeployerLifecycle(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	lifecycle := newTestResourceLifecycle(ResourceDeployer(), api.client())
+
+	state := lifecycle.apply(t, map[string]interface{}{
+		nameFieldName:       "plugin",
+		"securecn_deployer": []interface{}{map[string]interface{}{}},
+	})
+	if state.Attributes["securecn_deployer.0.deployer_id"] == "" || state.Attributes["deployer_type"] != "SecureCnDeployer" {
+		t.Fatalf("unexpected deployer state: %v", state.Attributes)
+	}
+
+	lifecycle.importState(t)
+
+	lifecycle.destroy(t)
+	if ids := api.objectIds(fakeApiDeployers); len(ids) != 0 {
+		t.Fatalf("expected the deployer to be deleted, got %v", ids)
+	}
+}
@@ -41,6 +41,9 @@ func ResourceDeploymentRule() *schema.Resource {
 		ReadContext:   resourceDeploymentRuleRead,
 		UpdateContext: resourceDeploymentRuleUpdate,
 		DeleteContext: resourceDeploymentRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Description:   "A SecureCN deployment rule",
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
@@ -207,12 +210,13 @@ func resourceDeploymentRuleCreate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	rule, err := serviceApi.CreateDeploymentRule(ctx, httpClientWrapper.HttpClient, deploymentRuleFromConfig)
+	rule := &model2.CdAppRule{}
+	err = serviceApi.DeploymentRules().Create(ctx, httpClientWrapper.HttpClient, deploymentRuleFromConfig, rule)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	ruleId := rule.Payload.ID
+	ruleId := rule.ID
 
 	d.SetId(string(ruleId))
 
@@ -227,16 +231,17 @@ func resourceDeploymentRuleRead(ctx context.Context, d *schema.ResourceData, m i
 	serviceApi := utils2.GetServiceApi(&httpClientWrapper)
 	ruleId := d.Id()
 
-	currentRuleInSecureCN, err := serviceApi.GetDeploymentRule(ctx, httpClientWrapper.HttpClient, strfmt.UUID(ruleId))
-	if err != nil {
+	currentRuleInSecureCN := &model2.CdAppRule{}
+	err := serviceApi.DeploymentRules().Get(ctx, httpClientWrapper.HttpClient, strfmt.UUID(ruleId), currentRuleInSecureCN)
+	if err != nil && !escherClient.IsNotFound(err) {
 		return diag.FromErr(err)
 	}
 
-	if currentRuleInSecureCN.Payload.ID == "" || currentRuleInSecureCN.Payload.Status == "DELETED" {
+	if escherClient.IsNotFound(err) || currentRuleInSecureCN.ID == "" || currentRuleInSecureCN.Status == "DELETED" {
 		// Tell terraform the rule doesn't exist
 		d.SetId("")
 	} else {
-		err = updateDeploymentRuleMutableFields(d, currentRuleInSecureCN.Payload)
+		err = updateDeploymentRuleMutableFields(d, currentRuleInSecureCN)
 		return diag.FromErr(err)
 	}
 
@@ -257,12 +262,13 @@ func resourceDeploymentRuleUpdate(ctx context.Context, d *schema.ResourceData, m
 
 	rule.ID = strfmt.UUID(d.Id())
 
-	updatedRule, err := serviceApi.UpdateDeploymentRule(ctx, httpClientWrapper.HttpClient, rule, rule.ID)
+	updatedRule := &model2.CdAppRule{}
+	err = serviceApi.DeploymentRules().Update(ctx, httpClientWrapper.HttpClient, rule.ID, rule, updatedRule)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.SetId(string(updatedRule.Payload.ID))
+	d.SetId(string(updatedRule.ID))
 
 	return resourceDeploymentRuleRead(ctx, d, m)
 }
@@ -273,7 +279,7 @@ func resourceDeploymentRuleDelete(ctx context.Context, d *schema.ResourceData, m
 	httpClientWrapper := m.(client.HttpClientWrapper)
 
 	serviceApi := utils2.GetServiceApi(&httpClientWrapper)
-	err := serviceApi.DeleteDeploymentRule(ctx, httpClientWrapper.HttpClient, strfmt.UUID(d.Id()))
+	err := serviceApi.DeploymentRules().Delete(ctx, httpClientWrapper.HttpClient, strfmt.UUID(d.Id()))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -461,7 +467,7 @@ func getPspProfileIdFromName(ctx context.Context, serviceApi *escherClient.MgmtS
 		return nil, fmt.Errorf("failed to get psp profile by name: %v. %v", podSecurityPolicyProfileName, err)
 	}
 
-	return &pspId.Payload, nil
+	return &pspId, nil
 
 }
 
@@ -518,6 +524,17 @@ func isEnforce(pspAction string) bool {
 	return pspAction == "ENFORCE"
 }
 
+func getScopeInTf(scope model2.WorkloadRuleScopeType) string {
+	switch scope {
+	case model2.WorkloadRuleScopeTypeClusterNameRuleType:
+		return "CLUSTER"
+	case model2.WorkloadRuleScopeTypeEnvironmentNameRuleType:
+		return "ENVIRONMENT"
+	default:
+		return "ANY"
+	}
+}
+
 func getStatusFromString(status string) model2.AppRuleStatus {
 	/*
 		for now we support only ENABLED
@@ -554,6 +571,14 @@ func updateDeploymentRuleMutableFields(d *schema.ResourceData, currentRuleInSecu
 	if err != nil {
 		return err
 	}
+	// the scope is case insensitive in the config, keep it as configured unless it changed
+	scopeInTf := d.Get(deploymentRuleScopeFieldName).(string)
+	if scopeInTf == "" || getScopeFromString(scopeInTf) != currentRuleInSecureCN.Scope {
+		err = d.Set(deploymentRuleScopeFieldName, getScopeInTf(currentRuleInSecureCN.Scope))
+		if err != nil {
+			return err
+		}
+	}
 
 	appInSecureCN := currentRuleInSecureCN.App()
 
@@ -562,16 +587,7 @@ func updateDeploymentRuleMutableFields(d *schema.ResourceData, currentRuleInSecu
 		_ = d.Set(matchByPodLabelFieldName, nil)
 		_ = d.Set(matchByPodAnyFieldName, nil)
 		appInSecureCNNames := appInSecureCN.(*model2.PodNameWorkloadRuleType)
-		appsInTf := make(map[string]interface{})
-		appsInTf[deploymentRuleVulnerabilitySeverityFieldName] = appInSecureCNNames.PodValidation.Vulnerability.HighestVulnerabilityAllowed
-		appsInTf[deploymentRuleVulnerabilityOnViolationActionFieldName] = appInSecureCNNames.PodValidation.Vulnerability.OnViolationAction
-		appsInTf[deploymentRulePSPProfileFieldName] = appInSecureCNNames.PodValidation.PodSecurityPolicy.PodSecurityPolicyName
-		mutate := appInSecureCNNames.PodValidation.PodSecurityPolicy.ShouldMutate
-		pspAction := appInSecureCNNames.PodValidation.PodSecurityPolicy.OnViolationAction
-		if *mutate {
-			pspAction = "ENFORCE"
-		}
-		appsInTf[deploymentRulePSPOnViolationActionFieldName] = pspAction
+		appsInTf := getPodValidationInTf(appInSecureCNNames.PodValidation)
 		appsInTf[deploymentRuleNamesFieldName] = appInSecureCNNames.Names
 		values := make([]map[string]interface{}, 0, 1)
 		values = append(values, appsInTf)
@@ -580,16 +596,7 @@ func updateDeploymentRuleMutableFields(d *schema.ResourceData, currentRuleInSecu
 		err = d.Set(matchByPodNameFieldName, nil)
 		err = d.Set(matchByPodAnyFieldName, nil)
 		appInSecureCNLabels := appInSecureCN.(*model2.PodLabelWorkloadRuleType)
-		appsInTf := make(map[string]interface{})
-		appsInTf[deploymentRuleVulnerabilitySeverityFieldName] = appInSecureCNLabels.PodValidation.Vulnerability.HighestVulnerabilityAllowed
-		appsInTf[deploymentRuleVulnerabilityOnViolationActionFieldName] = appInSecureCNLabels.PodValidation.Vulnerability.OnViolationAction
-		appsInTf[deploymentRulePSPProfileFieldName] = appInSecureCNLabels.PodValidation.PodSecurityPolicy.PodSecurityPolicyName
-		mutate := appInSecureCNLabels.PodValidation.PodSecurityPolicy.ShouldMutate
-		pspAction := appInSecureCNLabels.PodValidation.PodSecurityPolicy.OnViolationAction
-		if *mutate {
-			pspAction = "ENFORCE"
-		}
-		appsInTf[deploymentRulePSPOnViolationActionFieldName] = pspAction
+		appsInTf := getPodValidationInTf(appInSecureCNLabels.PodValidation)
 		appsInTf[deploymentRuleLabelsFieldName] = utils2.GetListStringFromLabels(appInSecureCNLabels.Labels)
 		values := make([]map[string]interface{}, 0, 1)
 		values = append(values, appsInTf)
@@ -598,16 +605,7 @@ func updateDeploymentRuleMutableFields(d *schema.ResourceData, currentRuleInSecu
 		err = d.Set(matchByPodNameFieldName, nil)
 		err = d.Set(matchByPodLabelFieldName, nil)
 		appInSecureCNAny := appInSecureCN.(*model2.PodAnyWorkloadRuleType)
-		appsInTf := make(map[string]interface{})
-		appsInTf[deploymentRuleVulnerabilitySeverityFieldName] = appInSecureCNAny.PodValidation.Vulnerability.HighestVulnerabilityAllowed
-		appsInTf[deploymentRuleVulnerabilityOnViolationActionFieldName] = appInSecureCNAny.PodValidation.Vulnerability.OnViolationAction
-		appsInTf[deploymentRulePSPProfileFieldName] = appInSecureCNAny.PodValidation.PodSecurityPolicy.PodSecurityPolicyName
-		mutate := appInSecureCNAny.PodValidation.PodSecurityPolicy.ShouldMutate
-		pspAction := appInSecureCNAny.PodValidation.PodSecurityPolicy.OnViolationAction
-		if *mutate {
-			pspAction = "ENFORCE"
-		}
-		appsInTf[deploymentRulePSPOnViolationActionFieldName] = pspAction
+		appsInTf := getPodValidationInTf(appInSecureCNAny.PodValidation)
 		values := make([]map[string]interface{}, 0, 1)
 		values = append(values, appsInTf)
 		err = d.Set(matchByPodAnyFieldName, values)
@@ -615,3 +613,26 @@ func updateDeploymentRuleMutableFields(d *schema.ResourceData, currentRuleInSecu
 
 	return err
 }
+
+func getPodValidationInTf(podValidation *model2.PodValidation) map[string]interface{} {
+	appInTf := make(map[string]interface{})
+	if podValidation == nil {
+		return appInTf
+	}
+
+	if vulnerability := podValidation.Vulnerability; vulnerability != nil {
+		appInTf[deploymentRuleVulnerabilitySeverityFieldName] = string(vulnerability.HighestVulnerabilityAllowed)
+		appInTf[deploymentRuleVulnerabilityOnViolationActionFieldName] = string(vulnerability.OnViolationAction)
+	}
+
+	if psp := podValidation.PodSecurityPolicy; psp != nil {
+		appInTf[deploymentRulePSPProfileFieldName] = psp.PodSecurityPolicyName
+		pspAction := string(psp.OnViolationAction)
+		if psp.ShouldMutate != nil && *psp.ShouldMutate {
+			pspAction = "ENFORCE"
+		}
+		appInTf[deploymentRulePSPOnViolationActionFieldName] = pspAction
+	}
+
+	return appInTf
+}
@@ -0,0 +1,50 @@
+package securecn
+
+import (
+	"testing"
+)
+
+func TestDeploymentRuleLifecycle(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	pspProfileId := api.addObject(fakeApiPspProfiles, map[string]interface{}{"name": "restricted"})
+	lifecycle := newTestResourceLifecycle(ResourceDeploymentRule(), api.client())
+
+	state := lifecycle.apply(t, map[string]interface{}{
+		deploymentRuleNameFieldName: "frontend",
+		matchByPodNameFieldName: []interface{}{
+			map[string]interface{}{
+				deploymentRuleNamesFieldName:                          []interface{}{"frontend"},
+				deploymentRuleVulnerabilitySeverityFieldName:          "HIGH",
+				deploymentRuleVulnerabilityOnViolationActionFieldName: "BLOCK",
+				deploymentRulePSPProfileFieldName:                     "restricted",
+				deploymentRulePSPOnViolationActionFieldName:           "ENFORCE",
+			},
+		},
+	})
+	rule := api.object(fakeApiDeploymentRules, state.ID)
+	podSecurityPolicy := rule["app"].(map[string]interface{})["podValidation"].(map[string]interface{})["podSecurityPolicy"].(map[string]interface{})
+	if podSecurityPolicy["podSecurityPolicyId"] != pspProfileId || podSecurityPolicy["shouldMutate"] != true {
+		t.Fatalf("expected the rule to enforce psp profile %s, got %v", pspProfileId, podSecurityPolicy)
+	}
+
+	lifecycle.importState(t)
+
+	updatedState := lifecycle.apply(t, map[string]interface{}{
+		deploymentRuleNameFieldName: "frontend by label",
+		matchByPodLabelFieldName: []interface{}{
+			map[string]interface{}{
+				deploymentRuleLabelsFieldName: map[string]interface{}{"app": "frontend"},
+			},
+		},
+	})
+	if rule := api.object(fakeApiDeploymentRules, state.ID); updatedState.ID != state.ID || rule["name"] != "frontend by label" {
+		t.Fatalf("expected the deployment rule to be updated in place, got %s: %v", updatedState.ID, rule)
+	}
+
+	lifecycle.importState(t)
+
+	lifecycle.destroy(t)
+	if ids := api.objectIds(fakeApiDeploymentRules); len(ids) != 0 {
+		t.Fatalf("expected the deployment rule to be deleted, got %v", ids)
+	}
+}
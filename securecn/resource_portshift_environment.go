@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"terraform-provider-securecn/internal/client"
 	"terraform-provider-securecn/internal/escher_api/escherClient"
 	model2 "terraform-provider-securecn/internal/escher_api/model"
@@ -12,14 +13,21 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const nameFieldName = "name"
 const descriptionFieldName = "description"
 const kubernetesEnvironmentFieldName = "kubernetes_environment"
 const clusterNameFieldName = "cluster_name"
+const clusterIdFieldName = "cluster_id"
+const preserveOriginalSourceIpFieldName = "preserve_original_source_ip"
 const namespacesNamesFieldName = "namespaces_by_names"
 const namespacesLabelsFieldName = "namespaces_by_labels"
+const namespacesLabelExpressionsFieldName = "namespaces_by_label_expressions"
+const labelExpressionKeyFieldName = "key"
+const labelExpressionOperatorFieldName = "operator"
+const labelExpressionValuesFieldName = "values"
 
 func ResourceEnvironment() *schema.Resource {
 
@@ -28,6 +36,9 @@ func ResourceEnvironment() *schema.Resource {
 		ReadContext:   resourceEnvironmentRead,
 		UpdateContext: resourceEnvironmentUpdate,
 		DeleteContext: resourceEnvironmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Description:   "A SecureCN environment",
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
@@ -43,19 +54,58 @@ func ResourceEnvironment() *schema.Resource {
 			kubernetesEnvironmentFieldName: {
 				Description: "The kubernetes environments to include in the SecureCN env",
 				Required:    true,
-				Type:        schema.TypeList,
+				Type:        schema.TypeSet,
 				MinItems:    1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						clusterNameFieldName: {
-							Description: "The name of the kubernetes cluster in SecureCN",
+							Description: "The name of the kubernetes cluster in SecureCN, exactly one of cluster_name and cluster_id must be specified",
 							Type:        schema.TypeString,
-							Required:    true,
+							Optional:    true,
+						},
+						clusterIdFieldName: {
+							Description:  "The ID of the kubernetes cluster in SecureCN, exactly one of cluster_name and cluster_id must be specified",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+						namespacesLabelExpressionsFieldName: {
+							Description: "The env will match using namespace label expressions, in addition to the namespace labels",
+							Optional:    true,
+							Type:        schema.TypeSet,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									labelExpressionKeyFieldName: {
+										Description:  "The label key",
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+									labelExpressionOperatorFieldName: {
+										Description:  "The operator of the expression, optional values: In, NotIn, Exists, DoesNotExist",
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{model2.LabelSelectorOperatorIn, model2.LabelSelectorOperatorNotIn, model2.LabelSelectorOperatorExists, model2.LabelSelectorOperatorDoesNotExist}, false),
+									},
+									labelExpressionValuesFieldName: {
+										Description: "The label values, required for In and NotIn and not allowed for Exists and DoesNotExist",
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						preserveOriginalSourceIpFieldName: {
+							Description: "Preserve the original source IP of connections to the environment, the cluster must have inspect_incoming_cluster_connections on",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
 						},
 						namespacesNamesFieldName: {
 							Description: "The env will match using namespace name",
 							Optional:    true,
-							Type:        schema.TypeList,
+							Type:        schema.TypeSet,
 							MinItems:    1,
 							Elem:        &schema.Schema{Type: schema.TypeString},
 						},
@@ -91,12 +141,24 @@ func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, m in
 		return diag.FromErr(err)
 	}
 
-	environment, err := serviceApi.CreateEnvironment(ctx, httpClientWrapper.HttpClient, environmentFromConfig)
+	environment := &model2.Environment{}
+	err = serviceApi.Environments().Create(ctx, httpClientWrapper.HttpClient, environmentFromConfig, environment)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	envId := environment.Payload.ID
+	envId := environment.ID
+
+	err = validateNamespaceLabelExpressionsSupported(environmentFromConfig, environment)
+	if err != nil {
+		// Don't leave behind an environment without the namespaces it was asked to select
+		deleteErr := serviceApi.Environments().Delete(ctx, httpClientWrapper.HttpClient, envId)
+		if deleteErr != nil {
+			d.SetId(string(envId))
+			return diag.Errorf("%s, failed to delete the created environment: %s", err, deleteErr)
+		}
+		return diag.FromErr(err)
+	}
 
 	d.SetId(string(envId))
 
@@ -111,16 +173,17 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, m inte
 	serviceApi := utils2.GetServiceApi(&httpClientWrapper)
 	envId := d.Id()
 
-	currentEnvInSecureCN, err := serviceApi.GetEnvironment(ctx, httpClientWrapper.HttpClient, strfmt.UUID(envId))
-	if err != nil {
+	currentEnvInSecureCN := &model2.Environment{}
+	err := serviceApi.Environments().Get(ctx, httpClientWrapper.HttpClient, strfmt.UUID(envId), currentEnvInSecureCN)
+	if err != nil && !escherClient.IsNotFound(err) {
 		return diag.FromErr(err)
 	}
 
-	if currentEnvInSecureCN.Payload.ID == "" {
+	if escherClient.IsNotFound(err) || currentEnvInSecureCN.ID == "" {
 		// Tell terraform the env doesn't exist
 		d.SetId("")
 	} else {
-		err = updateEnvironmentMutableFields(d, currentEnvInSecureCN.Payload)
+		err = updateEnvironmentMutableFields(d, currentEnvInSecureCN)
 		return diag.FromErr(err)
 	}
 
@@ -145,12 +208,34 @@ func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, m in
 	}
 	environment.ID = strfmt.UUID(d.Id())
 
-	updatedEnv, err := serviceApi.UpdateEnvironment(ctx, httpClientWrapper.HttpClient, environment, environment.ID)
+	// Keep the previous environment to restore it in case the server doesn't support label expressions
+	var previousEnv *model2.Environment
+	if hasNamespaceLabelExpressions(environment) {
+		previousEnv = &model2.Environment{}
+		err = serviceApi.Environments().Get(ctx, httpClientWrapper.HttpClient, environment.ID, previousEnv)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	updatedEnv := &model2.Environment{}
+	err = serviceApi.Environments().Update(ctx, httpClientWrapper.HttpClient, environment.ID, environment, updatedEnv)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.SetId(string(updatedEnv.Payload.ID))
+	err = validateNamespaceLabelExpressionsSupported(environment, updatedEnv)
+	if err != nil {
+		// Keep the previous state, the environment is restored to it
+		d.Partial(true)
+		restoreErr := serviceApi.Environments().Update(ctx, httpClientWrapper.HttpClient, environment.ID, previousEnv, &model2.Environment{})
+		if restoreErr != nil {
+			return diag.Errorf("%s, failed to restore the previous environment: %s", err, restoreErr)
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId(string(updatedEnv.ID))
 
 	return resourceEnvironmentRead(ctx, d, m)
 }
@@ -162,7 +247,7 @@ func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, m in
 
 	serviceApi := utils2.GetServiceApi(&httpClientWrapper)
 	envId := strfmt.UUID(d.Id())
-	err := serviceApi.DeleteEnvironment(ctx, httpClientWrapper.HttpClient, envId)
+	err := serviceApi.Environments().Delete(ctx, httpClientWrapper.HttpClient, envId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -175,14 +260,34 @@ func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, m in
 
 func validateEnvironmentConfig(d *schema.ResourceData) error {
 	log.Printf("[DEBUG] validating config")
-	allKubernetesEnvironments := d.Get(kubernetesEnvironmentFieldName).([]interface{})
-	for index, _ := range allKubernetesEnvironments {
-		namespaceNames := utils2.ReadNestedListStringFromTF(d, kubernetesEnvironmentFieldName, namespacesNamesFieldName, index)
-		namespaceLabels := utils2.GetLabelsFromMap(utils2.ReadNestedMapStringFromTF(d, kubernetesEnvironmentFieldName, namespacesLabelsFieldName, index))
+	clusters := make(map[string]bool)
+	for _, kubernetesEnvironment := range d.Get(kubernetesEnvironmentFieldName).(*schema.Set).List() {
+		kubernetesEnvironmentMap := kubernetesEnvironment.(map[string]interface{})
+		clusterName := kubernetesEnvironmentMap[clusterNameFieldName].(string)
+		clusterId := kubernetesEnvironmentMap[clusterIdFieldName].(string)
+		if (clusterName == "") == (clusterId == "") {
+			return fmt.Errorf("kubernetes_environment: exactly one of `cluster_name,cluster_id` must be specified")
+		}
+		cluster := clusterName + clusterId
+
+		for _, labelExpression := range getNamespaceLabelExpressionsFromTf(kubernetesEnvironmentMap) {
+			hasValues := len(labelExpression.Values) > 0
+			switch labelExpression.Operator {
+			case model2.LabelSelectorOperatorIn, model2.LabelSelectorOperatorNotIn:
+				if !hasValues {
+					return fmt.Errorf("kubernetes_environment of cluster %s: label expression of %s with operator %s requires values", cluster, labelExpression.Key, labelExpression.Operator)
+				}
+			default:
+				if hasValues {
+					return fmt.Errorf("kubernetes_environment of cluster %s: label expression of %s with operator %s can't have values", cluster, labelExpression.Key, labelExpression.Operator)
+				}
+			}
+		}
 
-		if namespaceNames != nil && namespaceLabels != nil {
-			return fmt.Errorf("kubernetes_environment.%d.namespaces_by_names\": one of `kubernetes_environment.%d.namespaces_by_names,kubernetes_environment.[%d].namespaces_by_labels` must be specified", index, index, index)
+		if clusters[cluster] {
+			return fmt.Errorf("kubernetes_environment of cluster %s is specified more than once", cluster)
 		}
+		clusters[cluster] = true
 	}
 	return nil
 }
@@ -195,21 +300,34 @@ func getEnvironmentFromConfig(ctx context.Context, d *schema.ResourceData, servi
 
 	kubernetesEnvs := make([]*model2.KubernetesEnvironment, 0)
 
-	allKubernetesEnvironments := d.Get(kubernetesEnvironmentFieldName).([]interface{})
-	for index, _ := range allKubernetesEnvironments {
-		clusterName := utils2.ReadNestedStringFromTF(d, kubernetesEnvironmentFieldName, clusterNameFieldName, index)
-		log.Printf("[DEBUG] %v clusterName: %v", index, clusterName)
-		namespaceNames := utils2.ReadNestedListStringFromTF(d, kubernetesEnvironmentFieldName, namespacesNamesFieldName, index)
-		log.Printf("[DEBUG] %v namespaceNames: %v", index, namespaceNames)
-		namespaceLabels := utils2.GetLabelsFromMap(utils2.ReadNestedMapStringFromTF(d, kubernetesEnvironmentFieldName, namespacesLabelsFieldName, index))
-		log.Printf("[DEBUG] %v namespaceLabels: %v", index, namespaceLabels)
+	for _, kubernetesEnvironment := range d.Get(kubernetesEnvironmentFieldName).(*schema.Set).List() {
+		kubernetesEnvironmentMap := kubernetesEnvironment.(map[string]interface{})
+		clusterName := kubernetesEnvironmentMap[clusterNameFieldName].(string)
+		clusterId := strfmt.UUID(kubernetesEnvironmentMap[clusterIdFieldName].(string))
+		log.Printf("[DEBUG] clusterName: %v, clusterId: %v", clusterName, clusterId)
+		namespaceNames := getNamespaceNamesFromTf(kubernetesEnvironmentMap)
+		log.Printf("[DEBUG] %v namespaceNames: %v", clusterName, namespaceNames)
+		namespaceLabels := utils2.GetLabelsFromMap(getNamespaceLabelsFromTf(kubernetesEnvironmentMap))
+		log.Printf("[DEBUG] %v namespaceLabels: %v", clusterName, namespaceLabels)
+		preserveOriginalSourceIp := kubernetesEnvironmentMap[preserveOriginalSourceIpFieldName].(bool)
+
+		if clusterId == "" {
+			clusterIdByName, err := serviceApi.GetKubernetesClusterIdByName(ctx, httpClientWrapper.HttpClient, clusterName)
+			if err != nil {
+				return nil, err
+			}
+			clusterId = clusterIdByName
+		}
 
-		clusterId, err := serviceApi.GetKubernetesClusterIdByName(ctx, httpClientWrapper.HttpClient, clusterName)
-		if err != nil {
-			return nil, err
+		if preserveOriginalSourceIp {
+			err := validateClusterInspectsIncomingConnections(ctx, serviceApi, httpClientWrapper, clusterId)
+			if err != nil {
+				return nil, err
+			}
 		}
 
-		kubeEnv := createKubernetesEnvFromConfig(namespaceNames, namespaceLabels, &clusterId.Payload)
+		kubeEnv := createKubernetesEnvFromConfig(namespaceNames, namespaceLabels, &clusterId, preserveOriginalSourceIp)
+		kubeEnv.NamespaceLabelExpressions = getNamespaceLabelExpressionsFromTf(kubernetesEnvironmentMap)
 
 		kubernetesEnvs = append(kubernetesEnvs, kubeEnv)
 	}
@@ -224,13 +342,107 @@ func getEnvironmentFromConfig(ctx context.Context, d *schema.ResourceData, servi
 	return env, nil
 }
 
-func createKubernetesEnvFromConfig(namespaceNames []string, namespaceLabels []*model2.Label, clusterID *strfmt.UUID) *model2.KubernetesEnvironment {
+func getNamespaceNamesFromTf(kubernetesEnvironment map[string]interface{}) []string {
+	namespaceNames := make([]string, 0)
+	if namespaceNamesSet, ok := kubernetesEnvironment[namespacesNamesFieldName].(*schema.Set); ok {
+		for _, namespaceName := range namespaceNamesSet.List() {
+			namespaceNames = append(namespaceNames, namespaceName.(string))
+		}
+	}
+	sort.Strings(namespaceNames)
+
+	return utils2.FilterEmptyStrings(namespaceNames)
+}
+
+func getNamespaceLabelsFromTf(kubernetesEnvironment map[string]interface{}) map[string]string {
+	namespaceLabels := make(map[string]string)
+	if namespaceLabelsMap, ok := kubernetesEnvironment[namespacesLabelsFieldName].(map[string]interface{}); ok {
+		for key, value := range namespaceLabelsMap {
+			namespaceLabels[key] = value.(string)
+		}
+	}
+
+	return namespaceLabels
+}
+
+func validateClusterInspectsIncomingConnections(ctx context.Context, serviceApi *escherClient.MgmtServiceApiCtx, httpClientWrapper client.HttpClientWrapper, clusterId strfmt.UUID) error {
+	cluster := &model2.KubernetesCluster{}
+	err := serviceApi.KubernetesClusters().Get(ctx, httpClientWrapper.HttpClient, clusterId, cluster)
+	if err != nil {
+		return err
+	}
+
+	if cluster.PreserveOriginalSourceIP == nil || !*cluster.PreserveOriginalSourceIP {
+		return fmt.Errorf("kubernetes_environment of cluster %s: %s requires %s to be on in the cluster", clusterId, preserveOriginalSourceIpFieldName, InspectIncomingClusterConnectionsFieldName)
+	}
+
+	return nil
+}
+
+func getNamespaceLabelExpressionsFromTf(kubernetesEnvironment map[string]interface{}) []*model2.LabelSelectorRequirement {
+	var labelExpressions []*model2.LabelSelectorRequirement
+	labelExpressionsSet, ok := kubernetesEnvironment[namespacesLabelExpressionsFieldName].(*schema.Set)
+	if !ok {
+		return labelExpressions
+	}
+
+	for _, labelExpression := range labelExpressionsSet.List() {
+		labelExpressionMap := labelExpression.(map[string]interface{})
+		values := make([]string, 0)
+		if valuesSet, ok := labelExpressionMap[labelExpressionValuesFieldName].(*schema.Set); ok {
+			for _, value := range valuesSet.List() {
+				values = append(values, value.(string))
+			}
+		}
+		sort.Strings(values)
+
+		labelExpressions = append(labelExpressions, &model2.LabelSelectorRequirement{
+			Key:      labelExpressionMap[labelExpressionKeyFieldName].(string),
+			Operator: labelExpressionMap[labelExpressionOperatorFieldName].(string),
+			Values:   values,
+		})
+	}
+
+	return labelExpressions
+}
+
+func hasNamespaceLabelExpressions(env *model2.Environment) bool {
+	for _, kubernetesEnv := range env.KubernetesEnvironments {
+		if len(kubernetesEnv.NamespaceLabelExpressions) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateNamespaceLabelExpressionsSupported fails when the server dropped the label expressions it was sent,
+// which means it doesn't support them
+func validateNamespaceLabelExpressionsSupported(sentEnv *model2.Environment, receivedEnv *model2.Environment) error {
+	receivedLabelExpressions := make(map[strfmt.UUID]int)
+	for _, kubernetesEnv := range receivedEnv.KubernetesEnvironments {
+		if kubernetesEnv != nil && kubernetesEnv.KubernetesCluster != nil {
+			receivedLabelExpressions[*kubernetesEnv.KubernetesCluster] = len(kubernetesEnv.NamespaceLabelExpressions)
+		}
+	}
+
+	for _, kubernetesEnv := range sentEnv.KubernetesEnvironments {
+		if len(kubernetesEnv.NamespaceLabelExpressions) > 0 && receivedLabelExpressions[*kubernetesEnv.KubernetesCluster] == 0 {
+			return fmt.Errorf("%s isn't supported by the SecureCN server", namespacesLabelExpressionsFieldName)
+		}
+	}
+
+	return nil
+}
+
+func createKubernetesEnvFromConfig(namespaceNames []string, namespaceLabels []*model2.Label, clusterID *strfmt.UUID, preserveOriginalSourceIp bool) *model2.KubernetesEnvironment {
 
 	return &model2.KubernetesEnvironment{
-		ID:                "",
-		KubernetesCluster: clusterID,
-		NamespaceLabels:   namespaceLabels,
-		Namespaces:        namespaceNames,
+		ID:                       "",
+		KubernetesCluster:        clusterID,
+		NamespaceLabels:          namespaceLabels,
+		Namespaces:               namespaceNames,
+		PreserveOriginalSourceIP: &preserveOriginalSourceIp,
 	}
 
 }
@@ -252,25 +464,64 @@ func updateEnvironmentMutableFields(d *schema.ResourceData, currentEnvInSecureCN
 	return err
 }
 
+// mutateKubernetesEnvs sets the kubernetes environments as they are in SecureCN,
+// they are matched to the config by cluster since kubernetes_environment is a set.
+// A cluster is referenced the same way it is in the config, by name otherwise
 func mutateKubernetesEnvs(d *schema.ResourceData, currentEnvInSecureCN *model2.Environment) error {
+	clustersReferencedById := make(map[string]bool)
+	for _, kubernetesEnvironment := range d.Get(kubernetesEnvironmentFieldName).(*schema.Set).List() {
+		if clusterId := kubernetesEnvironment.(map[string]interface{})[clusterIdFieldName].(string); clusterId != "" {
+			clustersReferencedById[clusterId] = true
+		}
+	}
+
+	envsInTf := make([]interface{}, 0, len(currentEnvInSecureCN.KubernetesEnvironments))
 
-	envsInSecureCN := currentEnvInSecureCN.KubernetesEnvironments
-
-	envsInTf := d.Get(kubernetesEnvironmentFieldName).([]interface{})
-
-	for index, envInSecureCN := range envsInSecureCN {
-		if index < len(envsInTf) {
-			envInTf := envsInTf[index].(map[string]interface{})
-			envInTf[clusterNameFieldName] = envInSecureCN.KubernetesClusterName
-			envInTf[namespacesNamesFieldName] = envInSecureCN.NamespaceLabels
-			envInTf[namespacesNamesFieldName] = envInSecureCN.Namespaces
-		} else {
-			newEnv := make(map[string]interface{}, 0)
-			newEnv[clusterNameFieldName] = envInSecureCN.KubernetesClusterName
-			newEnv[namespacesNamesFieldName] = envInSecureCN.NamespaceLabels
-			newEnv[namespacesNamesFieldName] = envInSecureCN.Namespaces
-			envsInTf = append(envsInTf, newEnv)
+	for _, envInSecureCN := range currentEnvInSecureCN.KubernetesEnvironments {
+		if envInSecureCN == nil {
+			continue
 		}
+
+		namespaceNames := make([]interface{}, 0, len(envInSecureCN.Namespaces))
+		for _, namespaceName := range envInSecureCN.Namespaces {
+			namespaceNames = append(namespaceNames, namespaceName)
+		}
+
+		namespaceLabels := make(map[string]interface{}, len(envInSecureCN.NamespaceLabels))
+		for key, value := range utils2.GetListStringFromLabels(utils2.FilterEmptyLabels(envInSecureCN.NamespaceLabels)) {
+			namespaceLabels[key] = value
+		}
+
+		labelExpressions := make([]interface{}, 0, len(envInSecureCN.NamespaceLabelExpressions))
+		for _, labelExpression := range envInSecureCN.NamespaceLabelExpressions {
+			if labelExpression == nil {
+				continue
+			}
+			values := make([]interface{}, 0, len(labelExpression.Values))
+			for _, value := range labelExpression.Values {
+				values = append(values, value)
+			}
+			labelExpressions = append(labelExpressions, map[string]interface{}{
+				labelExpressionKeyFieldName:      labelExpression.Key,
+				labelExpressionOperatorFieldName: labelExpression.Operator,
+				labelExpressionValuesFieldName:   values,
+			})
+		}
+
+		envInTf := map[string]interface{}{
+			clusterNameFieldName:                envInSecureCN.KubernetesClusterName,
+			clusterIdFieldName:                  "",
+			namespacesNamesFieldName:            namespaceNames,
+			namespacesLabelsFieldName:           namespaceLabels,
+			namespacesLabelExpressionsFieldName: labelExpressions,
+			preserveOriginalSourceIpFieldName:   envInSecureCN.PreserveOriginalSourceIP != nil && *envInSecureCN.PreserveOriginalSourceIP,
+		}
+		if envInSecureCN.KubernetesCluster != nil && clustersReferencedById[envInSecureCN.KubernetesCluster.String()] {
+			envInTf[clusterNameFieldName] = ""
+			envInTf[clusterIdFieldName] = envInSecureCN.KubernetesCluster.String()
+		}
+
+		envsInTf = append(envsInTf, envInTf)
 	}
 
 	err := d.Set(kubernetesEnvironmentFieldName, envsInTf)
@@ -0,0 +1,356 @@
+package securecn
+
+import (
+	"context"
+	"testing"
+
+	model2 "terraform-provider-securecn/internal/escher_api/model"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// addTestEnvironment stores an environment named "env" with the given kubernetes environments in the fake API
+func addTestEnvironment(api *fakeSecureCNApi, kubernetesEnvironments ...*model2.KubernetesEnvironment) string {
+	name := "env"
+	return api.addModel(fakeApiEnvironments, &model2.Environment{Name: &name, KubernetesEnvironments: kubernetesEnvironments})
+}
+
+func getTestEnvironmentResourceData(t *testing.T, envId string, kubernetesEnvironments []interface{}) *schema.ResourceData {
+	d := schema.TestResourceDataRaw(t, ResourceEnvironment().Schema, map[string]interface{}{
+		nameFieldName:                  "env",
+		kubernetesEnvironmentFieldName: kubernetesEnvironments,
+	})
+	d.SetId(envId)
+
+	return d
+}
+
+func getTestKubernetesEnvironment(t *testing.T, d *schema.ResourceData, clusterName string) map[string]interface{} {
+	for _, kubernetesEnvironment := range d.Get(kubernetesEnvironmentFieldName).(*schema.Set).List() {
+		kubernetesEnvironmentMap := kubernetesEnvironment.(map[string]interface{})
+		if kubernetesEnvironmentMap[clusterNameFieldName] == clusterName {
+			return kubernetesEnvironmentMap
+		}
+	}
+
+	t.Fatalf("kubernetes_environment of cluster %s wasn't found", clusterName)
+	return nil
+}
+
+func TestEnvironmentReadIsOrderInsensitive(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	clusterAId := strfmt.UUID(api.addCluster("cluster-a"))
+	clusterBId := strfmt.UUID(api.addCluster("cluster-b"))
+	envId := addTestEnvironment(api,
+		&model2.KubernetesEnvironment{KubernetesCluster: &clusterBId, NamespaceLabels: []*model2.Label{{Key: "app", Value: "b"}}},
+		&model2.KubernetesEnvironment{KubernetesCluster: &clusterAId, Namespaces: []string{"ns2", "ns1"}},
+	)
+
+	d := getTestEnvironmentResourceData(t, envId, []interface{}{
+		map[string]interface{}{clusterNameFieldName: "cluster-a", namespacesNamesFieldName: []interface{}{"ns1", "ns2"}},
+		map[string]interface{}{clusterNameFieldName: "cluster-b", namespacesLabelsFieldName: map[string]interface{}{"app": "b"}},
+	})
+	configured := d.Get(kubernetesEnvironmentFieldName).(*schema.Set)
+
+	diags := resourceEnvironmentRead(context.Background(), d, api.client())
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+
+	read := d.Get(kubernetesEnvironmentFieldName).(*schema.Set)
+	if !read.Equal(configured) {
+		t.Fatalf("expected no diff, configured: %v, read: %v", configured.List(), read.List())
+	}
+}
+
+func TestEnvironmentReadDetectsServerSideChanges(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	clusterAId := strfmt.UUID(api.addCluster("cluster-a"))
+	clusterBId := strfmt.UUID(api.addCluster("cluster-b"))
+	envId := addTestEnvironment(api,
+		&model2.KubernetesEnvironment{KubernetesCluster: &clusterAId, Namespaces: []string{"ns1", "ns3"}},
+		&model2.KubernetesEnvironment{KubernetesCluster: &clusterBId, NamespaceLabels: []*model2.Label{{Key: "app", Value: "c"}}},
+	)
+
+	d := getTestEnvironmentResourceData(t, envId, []interface{}{
+		map[string]interface{}{clusterNameFieldName: "cluster-a", namespacesNamesFieldName: []interface{}{"ns1", "ns2"}},
+		map[string]interface{}{clusterNameFieldName: "cluster-b", namespacesLabelsFieldName: map[string]interface{}{"app": "b"}},
+	})
+
+	diags := resourceEnvironmentRead(context.Background(), d, api.client())
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+
+	clusterA := getTestKubernetesEnvironment(t, d, "cluster-a")
+	namespaceNames := getNamespaceNamesFromTf(clusterA)
+	if len(namespaceNames) != 2 || namespaceNames[0] != "ns1" || namespaceNames[1] != "ns3" {
+		t.Fatalf("expected the namespaces of cluster-a to be read back, got: %v", namespaceNames)
+	}
+	if len(getNamespaceLabelsFromTf(clusterA)) != 0 {
+		t.Fatalf("expected no namespace labels for cluster-a, got: %v", getNamespaceLabelsFromTf(clusterA))
+	}
+
+	clusterB := getTestKubernetesEnvironment(t, d, "cluster-b")
+	if labels := getNamespaceLabelsFromTf(clusterB); len(labels) != 1 || labels["app"] != "c" {
+		t.Fatalf("expected the namespace labels of cluster-b to be read back, got: %v", labels)
+	}
+	if len(getNamespaceNamesFromTf(clusterB)) != 0 {
+		t.Fatalf("expected no namespace names for cluster-b, got: %v", getNamespaceNamesFromTf(clusterB))
+	}
+}
+
+func TestEnvironmentUpdateSendsEveryCluster(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	clusterAId := api.addCluster("cluster-a")
+	clusterBId := api.addCluster("cluster-b")
+	envId := addTestEnvironment(api)
+
+	d := getTestEnvironmentResourceData(t, envId, []interface{}{
+		map[string]interface{}{clusterNameFieldName: "cluster-a", namespacesNamesFieldName: []interface{}{"ns1"}},
+		map[string]interface{}{clusterNameFieldName: "cluster-b", namespacesLabelsFieldName: map[string]interface{}{"app": "b"}},
+	})
+
+	diags := resourceEnvironmentUpdate(context.Background(), d, api.client())
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+
+	env := &model2.Environment{}
+	api.decodeObject(fakeApiEnvironments, envId, env)
+	clusterIds := map[string]bool{}
+	for _, kubernetesEnvironment := range env.KubernetesEnvironments {
+		clusterIds[kubernetesEnvironment.KubernetesCluster.String()] = true
+	}
+	if len(clusterIds) != 2 || !clusterIds[clusterAId] || !clusterIds[clusterBId] {
+		t.Fatalf("expected both clusters to be sent, got: %v", clusterIds)
+	}
+}
+
+func TestEnvironmentConfigRejectsDuplicateClusters(t *testing.T) {
+	d := getTestEnvironmentResourceData(t, "", []interface{}{
+		map[string]interface{}{clusterNameFieldName: "cluster-a", namespacesNamesFieldName: []interface{}{"ns1"}},
+		map[string]interface{}{clusterNameFieldName: "cluster-a", namespacesNamesFieldName: []interface{}{"ns2"}},
+	})
+
+	if err := validateEnvironmentConfig(d); err == nil {
+		t.Fatal("expected duplicate clusters to be rejected")
+	}
+}
+
+func TestEnvironmentReadKeepsClusterReferencedById(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	clusterAId := strfmt.UUID(api.addCluster("cluster-a"))
+	clusterBId := strfmt.UUID(api.addCluster("cluster-b"))
+	envId := addTestEnvironment(api,
+		&model2.KubernetesEnvironment{KubernetesCluster: &clusterAId, Namespaces: []string{"ns1"}},
+		&model2.KubernetesEnvironment{KubernetesCluster: &clusterBId, Namespaces: []string{"ns1"}},
+	)
+
+	d := getTestEnvironmentResourceData(t, envId, []interface{}{
+		map[string]interface{}{clusterIdFieldName: string(clusterAId), namespacesNamesFieldName: []interface{}{"ns1"}},
+		map[string]interface{}{clusterNameFieldName: "cluster-b", namespacesNamesFieldName: []interface{}{"ns1"}},
+	})
+	configured := d.Get(kubernetesEnvironmentFieldName).(*schema.Set)
+
+	diags := resourceEnvironmentRead(context.Background(), d, api.client())
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+
+	read := d.Get(kubernetesEnvironmentFieldName).(*schema.Set)
+	if !read.Equal(configured) {
+		t.Fatalf("expected no diff, configured: %v, read: %v", configured.List(), read.List())
+	}
+}
+
+func TestEnvironmentPreserveOriginalSourceIpRequiresInspectIncomingConnections(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	clusterId := api.addCluster("cluster-a")
+	api.setServerFields(fakeApiClusters, clusterId, map[string]interface{}{"preserveOriginalSourceIp": false})
+	envId := addTestEnvironment(api)
+
+	d := getTestEnvironmentResourceData(t, envId, []interface{}{
+		map[string]interface{}{clusterIdFieldName: clusterId, preserveOriginalSourceIpFieldName: true},
+	})
+
+	diags := resourceEnvironmentUpdate(context.Background(), d, api.client())
+	if !diags.HasError() {
+		t.Fatal("expected an error when the cluster doesn't inspect incoming connections")
+	}
+
+	api.setServerFields(fakeApiClusters, clusterId, map[string]interface{}{"preserveOriginalSourceIp": true})
+	diags = resourceEnvironmentUpdate(context.Background(), d, api.client())
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+
+	env := &model2.Environment{}
+	api.decodeObject(fakeApiEnvironments, envId, env)
+	if !*env.KubernetesEnvironments[0].PreserveOriginalSourceIP {
+		t.Fatal("expected preserve original source ip to be sent")
+	}
+}
+
+func TestEnvironmentLabelExpressionsCombinedWithNames(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	api.addCluster("cluster-a")
+	envId := addTestEnvironment(api)
+
+	d := getTestEnvironmentResourceData(t, envId, []interface{}{
+		map[string]interface{}{
+			clusterNameFieldName:     "cluster-a",
+			namespacesNamesFieldName: []interface{}{"ns1"},
+			namespacesLabelExpressionsFieldName: []interface{}{
+				map[string]interface{}{labelExpressionKeyFieldName: "tier", labelExpressionOperatorFieldName: model2.LabelSelectorOperatorNotIn, labelExpressionValuesFieldName: []interface{}{"db", "cache"}},
+				map[string]interface{}{labelExpressionKeyFieldName: "team", labelExpressionOperatorFieldName: model2.LabelSelectorOperatorExists},
+			},
+		},
+	})
+	configured := d.Get(kubernetesEnvironmentFieldName).(*schema.Set)
+
+	if err := validateEnvironmentConfig(d); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	diags := resourceEnvironmentUpdate(context.Background(), d, api.client())
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+
+	env := &model2.Environment{}
+	api.decodeObject(fakeApiEnvironments, envId, env)
+	labelExpressions := env.KubernetesEnvironments[0].NamespaceLabelExpressions
+	if len(labelExpressions) != 2 || len(env.KubernetesEnvironments[0].Namespaces) != 1 {
+		t.Fatalf("expected both namespace names and label expressions to be sent, got: %v", env.KubernetesEnvironments[0])
+	}
+
+	read := d.Get(kubernetesEnvironmentFieldName).(*schema.Set)
+	if !read.Equal(configured) {
+		t.Fatalf("expected no diff, configured: %v, read: %v", configured.List(), read.List())
+	}
+}
+
+func TestEnvironmentConfigValidatesLabelExpressionValues(t *testing.T) {
+	for _, labelExpression := range []map[string]interface{}{
+		{labelExpressionKeyFieldName: "tier", labelExpressionOperatorFieldName: model2.LabelSelectorOperatorIn},
+		{labelExpressionKeyFieldName: "tier", labelExpressionOperatorFieldName: model2.LabelSelectorOperatorDoesNotExist, labelExpressionValuesFieldName: []interface{}{"db"}},
+	} {
+		d := getTestEnvironmentResourceData(t, "", []interface{}{
+			map[string]interface{}{clusterNameFieldName: "cluster-a", namespacesLabelExpressionsFieldName: []interface{}{labelExpression}},
+		})
+
+		if err := validateEnvironmentConfig(d); err == nil {
+			t.Fatalf("expected label expression %v to be rejected", labelExpression)
+		}
+	}
+}
+
+func TestEnvironmentLabelExpressionsUnsupportedByServer(t *testing.T) {
+	clusterId := strfmt.UUID(uuid.New().String())
+	sentEnv := &model2.Environment{KubernetesEnvironments: []*model2.KubernetesEnvironment{
+		{KubernetesCluster: &clusterId, NamespaceLabelExpressions: []*model2.LabelSelectorRequirement{{Key: "team", Operator: model2.LabelSelectorOperatorExists}}},
+	}}
+	receivedEnv := &model2.Environment{KubernetesEnvironments: []*model2.KubernetesEnvironment{
+		{KubernetesCluster: &clusterId},
+	}}
+
+	if err := validateNamespaceLabelExpressionsSupported(sentEnv, receivedEnv); err == nil {
+		t.Fatal("expected dropped label expressions to be reported")
+	}
+
+	if err := validateNamespaceLabelExpressionsSupported(sentEnv, sentEnv); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func getTestLabelExpressionsEnvironmentResourceData(t *testing.T, envId string) *schema.ResourceData {
+	return getTestEnvironmentResourceData(t, envId, []interface{}{
+		map[string]interface{}{
+			clusterNameFieldName: "cluster-a",
+			namespacesLabelExpressionsFieldName: []interface{}{
+				map[string]interface{}{labelExpressionKeyFieldName: "team", labelExpressionOperatorFieldName: model2.LabelSelectorOperatorExists},
+			},
+		},
+	})
+}
+
+func TestEnvironmentCreateWithLabelExpressionsUnsupportedByServerLeavesNothingBehind(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	api.addCluster("cluster-a")
+	api.dropLabelExpressions()
+
+	d := getTestLabelExpressionsEnvironmentResourceData(t, "")
+
+	diags := resourceEnvironmentCreate(context.Background(), d, api.client())
+	if !diags.HasError() {
+		t.Fatal("expected an error when the server doesn't support label expressions")
+	}
+
+	if d.Id() != "" {
+		t.Fatalf("expected no environment id to be stored, got %s", d.Id())
+	}
+	if ids := api.objectIds(fakeApiEnvironments); len(ids) != 0 {
+		t.Fatalf("expected the created environment to be deleted, got %v", ids)
+	}
+}
+
+func TestEnvironmentUpdateWithLabelExpressionsUnsupportedByServerRestoresPreviousEnvironment(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	clusterAId := strfmt.UUID(api.addCluster("cluster-a"))
+	envId := addTestEnvironment(api, &model2.KubernetesEnvironment{KubernetesCluster: &clusterAId, Namespaces: []string{"ns1"}})
+	api.dropLabelExpressions()
+
+	d := getTestLabelExpressionsEnvironmentResourceData(t, envId)
+
+	diags := resourceEnvironmentUpdate(context.Background(), d, api.client())
+	if !diags.HasError() {
+		t.Fatal("expected an error when the server doesn't support label expressions")
+	}
+
+	env := &model2.Environment{}
+	api.decodeObject(fakeApiEnvironments, envId, env)
+	if len(env.KubernetesEnvironments) != 1 || len(env.KubernetesEnvironments[0].Namespaces) != 1 || env.KubernetesEnvironments[0].Namespaces[0] != "ns1" {
+		t.Fatalf("expected the previous environment to be restored, got: %v", env.KubernetesEnvironments)
+	}
+}
+
+func TestEnvironmentLifecycle(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	api.addCluster("cluster-a", "ns1", "ns2")
+	api.addCluster("cluster-b")
+	lifecycle := newTestResourceLifecycle(ResourceEnvironment(), api.client())
+
+	state := lifecycle.apply(t, map[string]interface{}{
+		nameFieldName: "env",
+		kubernetesEnvironmentFieldName: []interface{}{
+			map[string]interface{}{clusterNameFieldName: "cluster-a", namespacesNamesFieldName: []interface{}{"ns1", "ns2"}},
+		},
+	})
+	env := api.object(fakeApiEnvironments, state.ID)
+	if env["name"] != "env" || len(env["kubernetesEnvironments"].([]interface{})) != 1 {
+		t.Fatalf("unexpected environment created: %v", env)
+	}
+
+	updatedState := lifecycle.apply(t, map[string]interface{}{
+		nameFieldName:        "env",
+		descriptionFieldName: "both clusters",
+		kubernetesEnvironmentFieldName: []interface{}{
+			map[string]interface{}{clusterNameFieldName: "cluster-a", namespacesNamesFieldName: []interface{}{"ns1"}},
+			map[string]interface{}{clusterNameFieldName: "cluster-b", namespacesLabelsFieldName: map[string]interface{}{"app": "b"}},
+		},
+	})
+	env = api.object(fakeApiEnvironments, state.ID)
+	if updatedState.ID != state.ID || env["description"] != "both clusters" || len(env["kubernetesEnvironments"].([]interface{})) != 2 {
+		t.Fatalf("expected the environment to be updated in place, got %s: %v", updatedState.ID, env)
+	}
+
+	lifecycle.importState(t)
+
+	lifecycle.destroy(t)
+	if ids := api.objectIds(fakeApiEnvironments); len(ids) != 0 {
+		t.Fatalf("expected the environment to be deleted, got %v", ids)
+	}
+}
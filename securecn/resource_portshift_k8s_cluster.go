@@ -11,12 +11,12 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"path/filepath"
 	"syscall"
 	"terraform-provider-securecn/internal/client"
 	"terraform-provider-securecn/internal/escher_api/escherClient"
 	"terraform-provider-securecn/internal/escher_api/model"
 	utils2 "terraform-provider-securecn/internal/utils"
-	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -30,6 +30,16 @@ const secureCNBundleFilePath = "securecn_bundle.tar.gz"
 const scriptFilePath = "install_bundle.sh"
 const uninstallCmd = "./" + scriptFilePath + " --uninstall"
 
+const installMethodScript = "script"
+const installMethodHelm = "helm"
+const installMethodNone = "none"
+
+const multiClusterCertsFolderPrefix = "securecn_multi_cluster_certs_"
+const multiClusterRootCertFileName = "root-cert.pem"
+const multiClusterCACertFileName = "ca-cert.pem"
+const multiClusterCAKeyFileName = "ca-key.pem"
+const multiClusterCertChainFileName = "cert-chain.pem"
+
 const vaultCertsGenFilePath = "certs_gen_vault.sh"
 const tracingCertsFilePath = "certs_gen_tracing.sh"
 const forceRemoveVaultCmd = " FORCE_REMOVE_VAULT=\"TRUE\""
@@ -38,6 +48,7 @@ const getPortshiftPodsFormat = "KUBECONFIG=%s kubectl get pods -n portshift"
 const describePortshiftPodsFormat = "KUBECONFIG=%s kubectl describe pods -n portshift"
 const useK8sContextCommandFormat = "kubectl config use-context"
 const viewK8sConfigCommand = "kubectl config view --raw"
+const clusterReachabilityTimeout = "30s"
 
 const KubernetesClusterContextFieldName = "kubernetes_cluster_context"
 const NameFieldName = "name"
@@ -77,6 +88,11 @@ const SidecarResourcesFieldNameProxyRequestsCpu = "proxy_requests_cpu"
 const SidecarResourcesFieldNameProxyRequestsMemory = "proxy_requests_memory"
 const MultiClusterCommunicationSupportFieldName = "multi_cluster_communication_support"
 const MultiClusterCommunicationSupportCertsPathFieldName = MultiClusterCommunicationSupportFieldName + "_certs_path"
+const MultiClusterCommunicationSupportCertsFieldName = MultiClusterCommunicationSupportFieldName + "_certs"
+const MultiClusterCommunicationSupportCertsFieldNameRootCert = "root_cert_pem"
+const MultiClusterCommunicationSupportCertsFieldNameCACert = "ca_cert_pem"
+const MultiClusterCommunicationSupportCertsFieldNameCAKey = "ca_key_pem"
+const MultiClusterCommunicationSupportCertsFieldNameCertChain = "cert_chain_pem"
 const InspectIncomingClusterConnectionsFieldName = "inspect_incoming_cluster_connections"
 const FailCloseFieldName = "fail_close"
 const PersistentStorageFieldName = "persistent_storage"
@@ -86,6 +102,14 @@ const MinimumReplicasFieldName = "minimum_replicas"
 const CiImageSignatureValidationFieldName = "ci_image_signer_validation_enabled"
 const SupportExternalTraceSourceFieldName = "support_external_trace_source"
 const AutoUpgradeControllerVersionFieldName = "auto_upgrade_controller_version"
+const ControllerVersionFieldName = "controller_version"
+const InstalledControllerVersionFieldName = "installed_controller_version"
+const InstallMethodFieldName = "install_method"
+const SkipUninstallOnDeleteFieldName = "skip_uninstall_on_delete"
+const IgnoreUnreachableClusterOnDeleteFieldName = "ignore_unreachable_cluster_on_delete"
+const DeleteProtectionFieldName = "delete_protection"
+const HelmValuesDigestFieldName = "helm_values_digest"
+const HelmReleaseRevisionFieldName = "helm_release_revision"
 
 func ResourceCluster() *schema.Resource {
 	return &schema.Resource{
@@ -111,6 +135,7 @@ func ResourceCluster() *schema.Resource {
 				}, Optional: true, Description: "when enabling Istio ingress, use these Istio ingress annotations"},
 			MultiClusterCommunicationSupportFieldName: {Type: schema.TypeBool, Optional: true, Default: false, Description: "Enable multi cluster communication"},
 			MultiClusterCommunicationSupportCertsPathFieldName: {Type: schema.TypeString, Optional: true, Default: "", Description: "Multi cluster certs path. Only valid if " + MultiClusterCommunicationSupportFieldName + " is true",
+				ConflictsWith: []string{MultiClusterCommunicationSupportCertsFieldName},
 				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
 					path := val.(string)
 					if _, err := os.Stat(path); path != "" && os.IsNotExist(err) {
@@ -119,6 +144,22 @@ func ResourceCluster() *schema.Resource {
 					return
 				},
 			},
+			MultiClusterCommunicationSupportCertsFieldName: {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				Sensitive:     true,
+				ConflictsWith: []string{MultiClusterCommunicationSupportCertsPathFieldName},
+				Description:   "Multi cluster certs, e.g. from securecn_multi_cluster_ca, instead of a certs path. Only valid if " + MultiClusterCommunicationSupportFieldName + " is true",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						MultiClusterCommunicationSupportCertsFieldNameRootCert:  {Type: schema.TypeString, Required: true, Description: "The PEM encoded root CA certificate"},
+						MultiClusterCommunicationSupportCertsFieldNameCACert:    {Type: schema.TypeString, Required: true, Description: "The PEM encoded intermediate CA certificate of the cluster"},
+						MultiClusterCommunicationSupportCertsFieldNameCAKey:     {Type: schema.TypeString, Required: true, Description: "The PEM encoded intermediate CA private key of the cluster"},
+						MultiClusterCommunicationSupportCertsFieldNameCertChain: {Type: schema.TypeString, Required: true, Description: "The PEM encoded certificate chain of the cluster"},
+					},
+				},
+			},
 			InspectIncomingClusterConnectionsFieldName: {Type: schema.TypeBool, Optional: true, Default: false, Description: "Enable enforcement and visibility of connections from external IP sources"},
 			FailCloseFieldName:                         {Type: schema.TypeBool, Optional: true, Default: false, Description: "When enabled, workloads and connections will be blocked in case SecureCN agent is not responding"},
 			PersistentStorageFieldName:                 {Type: schema.TypeBool, Optional: true, Default: false, Description: "Allow SecureCN agent to save the policy persistently, so it will be available after a restart of the pod. This will Require 128MB of storage for the agent pod."},
@@ -128,20 +169,23 @@ func ResourceCluster() *schema.Resource {
 					"GKE", "OPENSHIFT", "RANCHER", "AKS", "EKS", "KUBERNETES", "IKS",
 				}, true),
 			},
-			EnableApiIntelligenceDASTFieldName:       {Type: schema.TypeBool, Optional: true, Default: false, Description: "Enable API Intelligence DAST integration"},
-			EnableAutoLabelFieldName:                 {Type: schema.TypeBool, Optional: true, Default: false, Description: "Enable auto labeling of Kubernetes namespaces"},
-			HoldApplicationUntilProxyStartsFieldName: {Type: schema.TypeBool, Optional: true, Default: false, Description: "Indicates whether the controller should hold the application until the proxy starts"},
-			ServiceDiscoveryIsolationFieldName:       {Type: schema.TypeBool, Optional: true, Default: false, Description: "Indicates whether the service discovery isolation is enabled"},
-			TLSInspectionFieldName:                   {Type: schema.TypeBool, Optional: true, Computed: true, Description: "Indicates whether the TLS inspection is enabled"},
-			EnableK8sEventsFieldName:                 {Type: schema.TypeBool, Optional: true, Computed: true, Description: "indicates whether kubernetes events sending is enabled"},
-			DisableSshMonitorFieldName:               {Type: schema.TypeBool, Optional: true, Computed: false, Description: "indicates whether SSH monitoring is disabled"},
-			TokenInjectionFieldName:                  {Type: schema.TypeBool, Optional: true, Default: false, Description: "Indicates whether the token injection is enabled"},
-			SkipReadyCheckFieldName:                  {Type: schema.TypeBool, Optional: true, Default: false, Description: "Indicates whether the cluster installation should be async"},
-			RollbackOnControllerFailureFieldName:     {Type: schema.TypeBool, Optional: true, Default: true, Description: "delete cluster on controller installation failure. default = true"},
-			ExternalCAFieldName:                      {Type: schema.TypeBool, Optional: true, Default: false, Description: "Indicates whether to use external CA for this cluster"},
-			ForceRemoveVaultOnDeleteFieldName:        {Type: schema.TypeBool, Optional: true, Default: false, Description: "delete the vault namespace (that was created for token injection) on delete. default = false"},
-			InstallTracingSupportFieldName:           {Type: schema.TypeBool, Optional: true, Default: false, Description: "Indicates whether to install tracing support, enable for apiSecurity accounts"},
-			InstallEnvoyTracingSupportFieldName:      {Type: schema.TypeBool, Optional: true, Default: false, Description: "Indicates whether to install Envoy tracing support, available when install tracing support is true"},
+			EnableApiIntelligenceDASTFieldName:        {Type: schema.TypeBool, Optional: true, Default: false, Description: "Enable API Intelligence DAST integration"},
+			EnableAutoLabelFieldName:                  {Type: schema.TypeBool, Optional: true, Default: false, Description: "Enable auto labeling of Kubernetes namespaces"},
+			HoldApplicationUntilProxyStartsFieldName:  {Type: schema.TypeBool, Optional: true, Default: false, Description: "Indicates whether the controller should hold the application until the proxy starts"},
+			ServiceDiscoveryIsolationFieldName:        {Type: schema.TypeBool, Optional: true, Default: false, Description: "Indicates whether the service discovery isolation is enabled"},
+			TLSInspectionFieldName:                    {Type: schema.TypeBool, Optional: true, Computed: true, Description: "Indicates whether the TLS inspection is enabled"},
+			EnableK8sEventsFieldName:                  {Type: schema.TypeBool, Optional: true, Computed: true, Description: "indicates whether kubernetes events sending is enabled"},
+			DisableSshMonitorFieldName:                {Type: schema.TypeBool, Optional: true, Computed: false, Description: "indicates whether SSH monitoring is disabled"},
+			TokenInjectionFieldName:                   {Type: schema.TypeBool, Optional: true, Default: false, Description: "Indicates whether the token injection is enabled"},
+			SkipReadyCheckFieldName:                   {Type: schema.TypeBool, Optional: true, Default: false, Description: "Indicates whether the cluster installation should be async"},
+			RollbackOnControllerFailureFieldName:      {Type: schema.TypeBool, Optional: true, Default: true, Description: "delete cluster on controller installation failure. default = true"},
+			ExternalCAFieldName:                       {Type: schema.TypeBool, Optional: true, Default: false, Description: "Indicates whether to use external CA for this cluster"},
+			ForceRemoveVaultOnDeleteFieldName:         {Type: schema.TypeBool, Optional: true, Default: false, Description: "delete the vault namespace (that was created for token injection) on delete. default = false"},
+			SkipUninstallOnDeleteFieldName:            {Type: schema.TypeBool, Optional: true, Default: false, Description: "leave the controller installed on the k8s cluster on delete, only the cluster in SecureCN is deleted. default = false"},
+			IgnoreUnreachableClusterOnDeleteFieldName: {Type: schema.TypeBool, Optional: true, Default: false, Description: "delete the cluster in SecureCN even if the controller can't be uninstalled because the k8s cluster is unreachable. default = false"},
+			DeleteProtectionFieldName:                 {Type: schema.TypeBool, Optional: true, Default: false, Description: "fail the delete of the cluster, it has to be turned off to delete the cluster. default = false"},
+			InstallTracingSupportFieldName:            {Type: schema.TypeBool, Optional: true, Default: false, Description: "Indicates whether to install tracing support, enable for apiSecurity accounts"},
+			InstallEnvoyTracingSupportFieldName:       {Type: schema.TypeBool, Optional: true, Default: false, Description: "Indicates whether to install Envoy tracing support, available when install tracing support is true"},
 			InternalRegistryFieldName: {
 				Description: "Use an internal container registry for this cluster",
 				Optional:    true,
@@ -211,6 +255,11 @@ func ResourceCluster() *schema.Resource {
 			CiImageSignatureValidationFieldName:   {Type: schema.TypeBool, Optional: true, Default: false, Description: "indicates whether ci image signer validation is Enabled"},
 			SupportExternalTraceSourceFieldName:   {Type: schema.TypeBool, Optional: true, Default: false, Description: "indicates whether external trace sources are supported, available when install tracing support is true"},
 			AutoUpgradeControllerVersionFieldName: {Type: schema.TypeBool, Optional: true, Default: false, Description: "indicates whether upgrade the controller automatically"},
+			ControllerVersionFieldName:            {Type: schema.TypeString, Optional: true, Default: "", Description: "Pin the controller version to install, the latest version is installed when empty. Changing it upgrades or downgrades the installed controller"},
+			InstalledControllerVersionFieldName:   {Type: schema.TypeString, Computed: true, Description: "The controller version currently installed on the cluster"},
+			InstallMethodFieldName:                {Type: schema.TypeString, Optional: true, Default: installMethodScript, Description: "How the controller is installed on the cluster, optional values: script (the bundle install script), helm (the bundle helm chart), none (the cluster is only registered, e.g. when the controller is applied by a GitOps tool from securecn_k8s_cluster_bundle)", ValidateFunc: validation.StringInSlice([]string{installMethodScript, installMethodHelm, installMethodNone}, false)},
+			HelmValuesDigestFieldName:             {Type: schema.TypeString, Computed: true, Description: "The sha256 digest of the helm values the controller release was rendered with, when installed with helm"},
+			HelmReleaseRevisionFieldName:          {Type: schema.TypeInt, Computed: true, Description: "The revision of the controller helm release, when installed with helm"},
 		},
 	}
 }
@@ -232,25 +281,24 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, m interf
 		return diag.FromErr(err)
 	}
 
-	secureCNCluster, err := serviceApi.CreateKubernetesCluster(ctx, httpClientWrapper.HttpClient, kubernetesCluster)
+	secureCNCluster := &model.KubernetesCluster{}
+	err = serviceApi.KubernetesClusters().Create(ctx, httpClientWrapper.HttpClient, kubernetesCluster, secureCNCluster)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	clusterId := secureCNCluster.Payload.ID
+	clusterId := secureCNCluster.ID
 	k8sContext := d.Get(KubernetesClusterContextFieldName).(string)
-	multiClusterFolder := d.Get(MultiClusterCommunicationSupportCertsPathFieldName).(string)
-	tokenInjection := d.Get(TokenInjectionFieldName).(bool)
-	skipReadyCheck := d.Get(SkipReadyCheckFieldName).(bool)
 	rollbackOnFailure := d.Get(RollbackOnControllerFailureFieldName).(bool)
-	tracingEnabled := d.Get(InstallTracingSupportFieldName).(bool)
 	forceRemoveVault := d.Get(ForceRemoveVaultOnDeleteFieldName).(bool)
+	controllerVersion := d.Get(ControllerVersionFieldName).(string)
+	installMethod := d.Get(InstallMethodFieldName).(string)
 
-	err = installAgentWithTimeout(ctx, serviceApi, httpClientWrapper, clusterId, k8sContext, multiClusterFolder, tracingEnabled, tokenInjection, skipReadyCheck)
+	err = installAgentByInstallMethod(ctx, d, serviceApi, httpClientWrapper, clusterId)
 	if err != nil {
 		log.Println("[ERROR] Panoptica controller installation has failed")
 		if rollbackOnFailure {
-			rollBackOnAgentInstallationFailure(ctx, serviceApi, httpClientWrapper, clusterId, k8sContext, forceRemoveVault)
+			rollBackOnAgentInstallationFailure(ctx, serviceApi, httpClientWrapper, clusterId, controllerVersion, installMethod, k8sContext, forceRemoveVault)
 			return diag.FromErr(err)
 		} else {
 			log.Println("[ERROR] error while installing Panoptica controller. " +
@@ -262,15 +310,15 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, m interf
 	return resourceClusterRead(ctx, d, m)
 }
 
-func rollBackOnAgentInstallationFailure(ctx context.Context, serviceApi *escherClient.MgmtServiceApiCtx, httpClientWrapper client.HttpClientWrapper, clusterId strfmt.UUID, k8sContext string, forceRemoveVault bool) {
-	deleteClusterError := serviceApi.DeleteKubernetesCluster(ctx, httpClientWrapper.HttpClient, clusterId)
+func rollBackOnAgentInstallationFailure(ctx context.Context, serviceApi *escherClient.MgmtServiceApiCtx, httpClientWrapper client.HttpClientWrapper, clusterId strfmt.UUID, controllerVersion string, installMethod string, k8sContext string, forceRemoveVault bool) {
+	deleteClusterError := serviceApi.KubernetesClusters().Delete(ctx, httpClientWrapper.HttpClient, clusterId)
 	if deleteClusterError != nil {
 		log.Println("[WARN] failed to remove cluster from Panoptica:")
 		log.Println(deleteClusterError)
 	}
 
-	_ = printPortshiftNamespaceBeforeDeletingController(k8sContext)
-	deleteAgentError := deleteAgent(k8sContext, forceRemoveVault, ctx, serviceApi, httpClientWrapper, clusterId)
+	clusterAgentInstaller.printControllerPods(ctx, k8sContext)
+	deleteAgentError := deleteAgentByInstallMethod(installMethod, k8sContext, forceRemoveVault, ctx, serviceApi, httpClientWrapper, clusterId, controllerVersion)
 	if deleteAgentError != nil {
 		log.Println("[WARN] failed to uninstall controller: ")
 		log.Println(deleteAgentError)
@@ -290,16 +338,17 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, m interfac
 		return diag.FromErr(configErr)
 	}
 
-	secureCNCluster, err := serviceApi.GetKubernetesClusterById(ctx, httpClientWrapper.HttpClient, strfmt.UUID(clusterId))
-	if err != nil {
+	secureCNCluster := &model.KubernetesCluster{}
+	err := serviceApi.KubernetesClusters().Get(ctx, httpClientWrapper.HttpClient, strfmt.UUID(clusterId), secureCNCluster)
+	if err != nil && !escherClient.IsNotFound(err) {
 		return diag.FromErr(err)
 	}
 
-	if secureCNCluster.Payload.ID == "" {
+	if escherClient.IsNotFound(err) || secureCNCluster.ID == "" {
 		// Tell terraform the cluster doesn't exist
 		d.SetId("")
 	} else {
-		updateMutableFields(d, secureCNCluster.Payload)
+		updateMutableFields(d, secureCNCluster)
 	}
 
 	return nil
@@ -322,35 +371,50 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, m interf
 		return diag.FromErr(err)
 	}
 
-	updatedCluster, err := serviceApi.UpdateKubernetesCluster(ctx, httpClientWrapper.HttpClient, kubernetesClusterFromConfig, strfmt.UUID(d.Id()))
+	updatedCluster := &model.KubernetesCluster{}
+	err = serviceApi.KubernetesClusters().Update(ctx, httpClientWrapper.HttpClient, strfmt.UUID(d.Id()), kubernetesClusterFromConfig, updatedCluster)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	err = updateAgent(ctx, d, updatedCluster.Payload, serviceApi, httpClientWrapper)
+	err = updateAgent(ctx, d, updatedCluster, serviceApi, httpClientWrapper)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.SetId(string(updatedCluster.Payload.ID))
-	updateMutableFields(d, updatedCluster.Payload)
+	d.SetId(string(updatedCluster.ID))
+	updateMutableFields(d, updatedCluster)
 	return nil
 }
 
 func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Print("[DEBUG] deleting cluster")
 
+	if d.Get(DeleteProtectionFieldName).(bool) {
+		return diag.FromErr(errors.New(fmt.Sprintf("can't delete cluster %s while %s is on", d.Get(NameFieldName).(string), DeleteProtectionFieldName)))
+	}
+
 	httpClientWrapper := m.(client.HttpClientWrapper)
 	serviceApi := utils2.GetServiceApi(&httpClientWrapper)
 	clusterId := strfmt.UUID(d.Id())
 	k8sContext := d.Get(KubernetesClusterContextFieldName).(string)
 	forceRemoveVault := d.Get(ForceRemoveVaultOnDeleteFieldName).(bool)
-	err := deleteAgent(k8sContext, forceRemoveVault, ctx, serviceApi, httpClientWrapper, clusterId)
-	if err != nil {
-		return diag.FromErr(err)
+	controllerVersion := d.Get(ControllerVersionFieldName).(string)
+	installMethod := d.Get(InstallMethodFieldName).(string)
+
+	if d.Get(SkipUninstallOnDeleteFieldName).(bool) {
+		log.Print("[DEBUG] skipping agent uninstallation according to '" + SkipUninstallOnDeleteFieldName + "' field")
+	} else {
+		err := deleteAgentByInstallMethod(installMethod, k8sContext, forceRemoveVault, ctx, serviceApi, httpClientWrapper, clusterId, controllerVersion)
+		if err != nil {
+			if !d.Get(IgnoreUnreachableClusterOnDeleteFieldName).(bool) || clusterAgentInstaller.isClusterReachable(ctx, k8sContext) {
+				return diag.FromErr(err)
+			}
+			log.Printf("[WARN] k8s context %s is unreachable, the controller wasn't uninstalled: %s", k8sContext, err)
+		}
 	}
 
-	err = serviceApi.DeleteKubernetesCluster(ctx, httpClientWrapper.HttpClient, clusterId)
+	err := serviceApi.KubernetesClusters().Delete(ctx, httpClientWrapper.HttpClient, clusterId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -361,11 +425,99 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, m interf
 	return nil
 }
 
-func installAgent(ctx context.Context, serviceApi *escherClient.MgmtServiceApiCtx, httpClientWrapper client.HttpClientWrapper, clusterId strfmt.UUID, context string, multiClusterFolder string, tracingEnabled bool, tokenInjection bool, skipReadyCheck bool) error {
+func installAgentByInstallMethod(ctx context.Context, d *schema.ResourceData, serviceApi *escherClient.MgmtServiceApiCtx, httpClientWrapper client.HttpClientWrapper, clusterId strfmt.UUID) error {
+	k8sContext := d.Get(KubernetesClusterContextFieldName).(string)
+	skipReadyCheck := d.Get(SkipReadyCheckFieldName).(bool)
+	controllerVersion := d.Get(ControllerVersionFieldName).(string)
+
+	installMethod := d.Get(InstallMethodFieldName).(string)
+	if installMethod == installMethodNone {
+		log.Print("[DEBUG] skipping agent installation")
+		_ = d.Set(HelmValuesDigestFieldName, "")
+		_ = d.Set(HelmReleaseRevisionFieldName, 0)
+		return nil
+	}
+
+	installation := agentInstallation{
+		clusterId:         clusterId,
+		controllerVersion: controllerVersion,
+		k8sContext:        k8sContext,
+		installMethod:     installMethod,
+		skipReadyCheck:    skipReadyCheck,
+	}
+
+	if installMethod == installMethodHelm {
+		helmResult, err := clusterAgentInstaller.install(ctx, serviceApi, httpClientWrapper, installation)
+		if err != nil {
+			return err
+		}
+		_ = d.Set(HelmValuesDigestFieldName, helmResult.valuesDigest)
+		_ = d.Set(HelmReleaseRevisionFieldName, helmResult.revision)
+		return nil
+	}
+
+	_ = d.Set(HelmValuesDigestFieldName, "")
+	_ = d.Set(HelmReleaseRevisionFieldName, 0)
+
+	installation.multiClusterFolder = d.Get(MultiClusterCommunicationSupportCertsPathFieldName).(string)
+	installation.tracingEnabled = d.Get(InstallTracingSupportFieldName).(bool)
+	installation.tokenInjection = d.Get(TokenInjectionFieldName).(bool)
+	if len(d.Get(MultiClusterCommunicationSupportCertsFieldName).([]interface{})) > 0 {
+		certsFolder, err := writeMultiClusterCerts(d)
+		if err != nil {
+			return err
+		}
+		defer removeDirectory(certsFolder)
+		installation.multiClusterFolder = certsFolder
+	}
+
+	return installAgentWithTimeout(ctx, serviceApi, httpClientWrapper, installation)
+}
+
+// writeMultiClusterCerts writes the certs to a temporary folder, in the layout of an istio plugged-in CA
+func writeMultiClusterCerts(d *schema.ResourceData) (string, error) {
+	certsFolder, err := ioutil.TempDir("", multiClusterCertsFolderPrefix)
+	if err != nil {
+		return "", err
+	}
+
+	certFiles := map[string]string{
+		multiClusterRootCertFileName:  MultiClusterCommunicationSupportCertsFieldNameRootCert,
+		multiClusterCACertFileName:    MultiClusterCommunicationSupportCertsFieldNameCACert,
+		multiClusterCAKeyFileName:     MultiClusterCommunicationSupportCertsFieldNameCAKey,
+		multiClusterCertChainFileName: MultiClusterCommunicationSupportCertsFieldNameCertChain,
+	}
+	for fileName, fieldName := range certFiles {
+		err = ioutil.WriteFile(filepath.Join(certsFolder, fileName), []byte(utils2.ReadNestedStringFromTF(d, MultiClusterCommunicationSupportCertsFieldName, fieldName, 0)), 0600)
+		if err != nil {
+			_ = removeDirectory(certsFolder)
+			return "", err
+		}
+	}
+
+	return certsFolder, nil
+}
+
+func deleteAgentByInstallMethod(installMethod string, k8sContext string, removeVault bool, ctx context.Context, serviceApi *escherClient.MgmtServiceApiCtx, httpClientWrapper client.HttpClientWrapper, clusterId strfmt.UUID, controllerVersion string) error {
+	if installMethod == installMethodNone {
+		log.Print("[DEBUG] skipping agent uninstallation")
+		return nil
+	}
+
+	return clusterAgentInstaller.uninstall(ctx, serviceApi, httpClientWrapper, agentInstallation{
+		clusterId:         clusterId,
+		controllerVersion: controllerVersion,
+		k8sContext:        k8sContext,
+		installMethod:     installMethod,
+		removeVault:       removeVault,
+	})
+}
+
+func installAgent(ctx context.Context, serviceApi *escherClient.MgmtServiceApiCtx, httpClientWrapper client.HttpClientWrapper, clusterId strfmt.UUID, controllerVersion string, context string, multiClusterFolder string, tracingEnabled bool, tokenInjection bool, skipReadyCheck bool) error {
 	log.Print("[DEBUG] installing agent")
 
 	rootPath, _ := syscall.Getwd()
-	installationDir, kubeconfig, err := setUpInstallation(ctx, serviceApi, httpClientWrapper, clusterId, context)
+	installationDir, kubeconfig, err := setUpInstallation(ctx, serviceApi, httpClientWrapper, clusterId, controllerVersion, context)
 	if err != nil {
 		return err
 	}
@@ -386,7 +538,7 @@ func installAgent(ctx context.Context, serviceApi *escherClient.MgmtServiceApiCt
 		}
 	}
 
-	output, err := utils2.ExecuteScript(scriptFilePath, multiClusterFolder, skipReadyCheck, kubeconfig)
+	output, err := utils2.ExecuteScript(ctx, scriptFilePath, multiClusterFolder, skipReadyCheck, kubeconfig)
 	if err != nil {
 		log.Print("[DEBUG] controller installation failed")
 		return fmt.Errorf("%s:\n%s", err, output)
@@ -400,7 +552,8 @@ func clearInstallationDir(rootPath string, installationDir string) {
 	removeDirectory(installationDir)
 }
 
-func setUpInstallation(ctx context.Context, serviceApi *escherClient.MgmtServiceApiCtx, httpClientWrapper client.HttpClientWrapper, clusterId strfmt.UUID, k8sContext string) (string, string, error) {
+func setUpInstallation(ctx context.Context, serviceApi *escherClient.MgmtServiceApiCtx, httpClientWrapper client.HttpClientWrapper, clusterId strfmt.UUID, controllerVersion string, k8sContext string) (string, string, error) {
+	rootPath, _ := syscall.Getwd()
 	installationDir := installationDirPrefix + uuid.New().String()
 	if err := os.Mkdir(installationDir, os.ModePerm); err != nil {
 		return "", "", err
@@ -408,31 +561,41 @@ func setUpInstallation(ctx context.Context, serviceApi *escherClient.MgmtService
 
 	os.Chdir(installationDir)
 
-	kubeconfig, err := createTempKubeconfig(k8sContext)
+	kubeconfig, err := createTempKubeconfig(ctx, k8sContext)
 	if err != nil {
+		clearInstallationDir(rootPath, installationDir)
 		return "", "", err
 	}
 
-	err = downloadAndExtractBundle(ctx, serviceApi, httpClientWrapper, clusterId)
+	err = downloadAndExtractBundle(ctx, serviceApi, httpClientWrapper, clusterId, controllerVersion)
 	if err != nil {
+		clearInstallationDir(rootPath, installationDir)
 		return "", "", err
 	}
 
 	err = utils2.MakeExecutable("./" + scriptFilePath)
 	if err != nil {
+		clearInstallationDir(rootPath, installationDir)
 		return "", "", err
 	}
 
 	return installationDir, kubeconfig, err
 }
 
-func installAgentWithTimeout(ctx context.Context, serviceApi *escherClient.MgmtServiceApiCtx, httpClientWrapper client.HttpClientWrapper, clusterId strfmt.UUID, context string, multiClusterFolder string, tracingEnabled bool, tokenInjection bool, skipReadyCheck bool) error {
+func installAgentWithTimeout(ctx context.Context, serviceApi *escherClient.MgmtServiceApiCtx, httpClientWrapper client.HttpClientWrapper, installation agentInstallation) error {
+	installationCtx, cancel := context.WithTimeout(ctx, agentInstallationTimeout)
+	defer cancel()
+
 	err := make(chan error, 1)
 	go func() {
-		err <- installAgent(ctx, serviceApi, httpClientWrapper, clusterId, context, multiClusterFolder, tracingEnabled, tokenInjection, skipReadyCheck)
+		_, installErr := clusterAgentInstaller.install(installationCtx, serviceApi, httpClientWrapper, installation)
+		err <- installErr
 	}()
 	select {
-	case <-time.After(15 * time.Minute):
+	case <-installationCtx.Done():
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
 		return errors.New("timed out during Panoptica controller installation process")
 	case err := <-err:
 		return err
@@ -448,33 +611,33 @@ func removeDirectory(installationDir string) error {
 	return nil
 }
 
-func printPortshiftNamespaceBeforeDeletingController(context string) error {
-	kubeconfig, err := createTempKubeconfig(context)
+func printPortshiftNamespaceBeforeDeletingController(ctx context.Context, k8sContext string) error {
+	kubeconfig, err := createTempKubeconfig(ctx, k8sContext)
 	if err != nil {
 		return err
 	}
 
 	defer os.Remove(kubeconfig)
-	getPodsResult, _ := utils2.ExecBashCommand(fmt.Sprintf(getPortshiftPodsFormat, kubeconfig))
+	getPodsResult, _ := utils2.ExecBashCommand(ctx, fmt.Sprintf(getPortshiftPodsFormat, kubeconfig))
 	log.Printf("[DEBUG] get pods result: \n" + getPodsResult)
 
-	describePodsResult, _ := utils2.ExecBashCommand(fmt.Sprintf(describePortshiftPodsFormat, kubeconfig))
+	describePodsResult, _ := utils2.ExecBashCommand(ctx, fmt.Sprintf(describePortshiftPodsFormat, kubeconfig))
 	log.Printf("[DEBUG] describe pods result: \n" + describePodsResult)
 
 	return nil
 }
 
-func deleteAgent(k8sContext string, removeVault bool, ctx context.Context, serviceApi *escherClient.MgmtServiceApiCtx, httpClientWrapper client.HttpClientWrapper, clusterId strfmt.UUID) error {
+func deleteAgent(k8sContext string, removeVault bool, ctx context.Context, serviceApi *escherClient.MgmtServiceApiCtx, httpClientWrapper client.HttpClientWrapper, clusterId strfmt.UUID, controllerVersion string) error {
 	log.Printf("[DEBUG] deleting agent from k8sContext: " + k8sContext)
 
 	rootPath, _ := syscall.Getwd()
-	installationDir, kubeconfig, err := setUpInstallation(ctx, serviceApi, httpClientWrapper, clusterId, k8sContext)
+	installationDir, kubeconfig, err := setUpInstallation(ctx, serviceApi, httpClientWrapper, clusterId, controllerVersion, k8sContext)
 	if err != nil {
 		return err
 	}
 	defer clearInstallationDir(rootPath, installationDir)
 
-	output, err := utils2.ExecBashCommand(fmt.Sprintf("KUBECONFIG=%s %s", kubeconfig, uninstallCmd))
+	output, err := utils2.ExecBashCommand(ctx, fmt.Sprintf("KUBECONFIG=%s %s", kubeconfig, uninstallCmd))
 	log.Printf("[INFO] " + output)
 	if err != nil {
 		return err
@@ -483,10 +646,20 @@ func deleteAgent(k8sContext string, removeVault bool, ctx context.Context, servi
 	return nil
 }
 
-func createTempKubeconfig(context string) (string, error) {
-	log.Print("[DEBUG] changing k8s context to " + context)
+func isClusterReachable(ctx context.Context, k8sContext string) bool {
+	_, err := utils2.ExecCommand(ctx, "kubectl", "--context", k8sContext, "--request-timeout", clusterReachabilityTimeout, "get", "--raw", "/version")
+	if err != nil {
+		log.Printf("[DEBUG] k8s context %s is unreachable: %s", k8sContext, err)
+		return false
+	}
 
-	kubeconfig, err := utils2.ExecBashCommand(viewK8sConfigCommand)
+	return true
+}
+
+func createTempKubeconfig(ctx context.Context, k8sContext string) (string, error) {
+	log.Print("[DEBUG] changing k8s context to " + k8sContext)
+
+	kubeconfig, err := utils2.ExecBashCommand(ctx, viewK8sConfigCommand)
 	if err != nil {
 		log.Print("[DEBUG] failed to print k8s config: " + err.Error())
 		return "", err
@@ -510,8 +683,8 @@ func createTempKubeconfig(context string) (string, error) {
 		return "", err
 	}
 
-	changeContextCommand := fmt.Sprintf("KUBECONFIG=%s %s %s", kubeconfigfile.Name(), useK8sContextCommandFormat, context)
-	_, err = utils2.ExecBashCommand(changeContextCommand)
+	changeContextCommand := fmt.Sprintf("KUBECONFIG=%s %s %s", kubeconfigfile.Name(), useK8sContextCommandFormat, k8sContext)
+	_, err = utils2.ExecBashCommand(ctx, changeContextCommand)
 	if err != nil {
 		log.Print("[DEBUG] failed to change k8s context: " + err.Error())
 		return "", err
@@ -520,10 +693,10 @@ func createTempKubeconfig(context string) (string, error) {
 	return kubeconfigfile.Name(), nil
 }
 
-func downloadAndExtractBundle(ctx context.Context, serviceApi *escherClient.MgmtServiceApiCtx, httpClientWrapper client.HttpClientWrapper, clusterId strfmt.UUID) error {
+func downloadAndExtractBundle(ctx context.Context, serviceApi *escherClient.MgmtServiceApiCtx, httpClientWrapper client.HttpClientWrapper, clusterId strfmt.UUID, controllerVersion string) error {
 	log.Print("[DEBUG] downloading and extracting bundle")
 
-	err := downloadInstallBundle(ctx, serviceApi, httpClientWrapper.HttpClient, clusterId, secureCNBundleFilePath)
+	err := downloadInstallBundle(ctx, serviceApi, httpClientWrapper.HttpClient, clusterId, controllerVersion, secureCNBundleFilePath)
 	if err != nil {
 		return err
 	}
@@ -532,7 +705,7 @@ func downloadAndExtractBundle(ctx context.Context, serviceApi *escherClient.Mgmt
 		return err
 	}
 
-	err = utils2.ExtractTarGz(open)
+	err = utils2.ExtractTarGz(open, ".")
 	if err != nil {
 		return err
 	}
@@ -661,7 +834,7 @@ func getClusterFromConfig(d *schema.ResourceData) (*model.KubernetesCluster, err
 	return cluster, nil
 }
 
-func downloadInstallBundle(ctx context.Context, serviceApi *escherClient.MgmtServiceApiCtx, client *http.Client, clusterId strfmt.UUID, bundlePath string) error {
+func downloadInstallBundle(ctx context.Context, serviceApi *escherClient.MgmtServiceApiCtx, client *http.Client, clusterId strfmt.UUID, controllerVersion string, bundlePath string) error {
 	log.Print("[DEBUG] downloading file")
 
 	file, err := os.Create(bundlePath)
@@ -669,7 +842,7 @@ func downloadInstallBundle(ctx context.Context, serviceApi *escherClient.MgmtSer
 		return err
 	}
 	buffer := new(bytes.Buffer)
-	err = serviceApi.DownloadKubernetesSecureCNBundle(ctx, client, buffer, clusterId)
+	err = serviceApi.DownloadKubernetesSecureCNBundle(ctx, client, buffer, clusterId, controllerVersion)
 	if err != nil {
 		return err
 	}
@@ -725,26 +898,28 @@ func updateMutableFields(d *schema.ResourceData, secureCNCluster *model.Kubernet
 	_ = d.Set(MinimumReplicasFieldName, secureCNCluster.MinimalNumberOfControllerReplicas)
 	_ = d.Set(ExternalCAFieldName, secureCNCluster.ExternalCa)
 	_ = d.Set(AutoUpgradeControllerVersionFieldName, secureCNCluster.AutoUpgradeControllerVersion)
+	_ = d.Set(InstalledControllerVersionFieldName, secureCNCluster.ControllerVersion)
 
 	if secureCNCluster.InternalRegistryParameters == nil {
 		_ = d.Set(InternalRegistryFieldName, nil)
 	} else {
-		_ = d.Set(InternalRegistryFieldName, utils2.GetTfMapFromKeyValuePairs([]utils2.KeyValue{{
-			InternalRegistryFieldNameUrl, secureCNCluster.InternalRegistryParameters.InternalRegistry}}))
+		_ = d.Set(InternalRegistryFieldName, utils2.GetTfMapFromKeyValuePairs([]utils2.KeyValue{
+			{Key: InternalRegistryFieldNameUrl, Value: secureCNCluster.InternalRegistryParameters.InternalRegistry}}))
 	}
 
-	if secureCNCluster.SidecarsResources == nil {
+	// the provider always sends the sidecar resources, an empty one means they aren't configured
+	if secureCNCluster.SidecarsResources == nil || *secureCNCluster.SidecarsResources == (model.SidecarsResource{}) {
 		_ = d.Set(SidecarResourcesFieldName, nil)
 	} else {
 		_ = d.Set(SidecarResourcesFieldName, utils2.GetTfMapFromKeyValuePairs([]utils2.KeyValue{
-			{SidecarResourcesFieldNameProxyInitLimitsCpu, secureCNCluster.SidecarsResources.ProxyInitLimitsCPU},
-			{SidecarResourcesFieldNameProxyInitLimitsMemory, secureCNCluster.SidecarsResources.ProxyInitLimitsMemory},
-			{SidecarResourcesFieldNameProxyInitRequestsCpu, secureCNCluster.SidecarsResources.ProxyInitRequestsCPU},
-			{SidecarResourcesFieldNameProxyInitRequestsMemory, secureCNCluster.SidecarsResources.ProxyInitRequestsMemory},
-			{SidecarResourcesFieldNameProxyLimitsCpu, secureCNCluster.SidecarsResources.ProxyLimitsCPU},
-			{SidecarResourcesFieldNameProxyLimitsMemory, secureCNCluster.SidecarsResources.ProxyLimitsMemory},
-			{SidecarResourcesFieldNameProxyRequestsCpu, secureCNCluster.SidecarsResources.ProxyRequestCPU},
-			{SidecarResourcesFieldNameProxyRequestsMemory, secureCNCluster.SidecarsResources.ProxyRequestMemory}}))
+			{Key: SidecarResourcesFieldNameProxyInitLimitsCpu, Value: secureCNCluster.SidecarsResources.ProxyInitLimitsCPU},
+			{Key: SidecarResourcesFieldNameProxyInitLimitsMemory, Value: secureCNCluster.SidecarsResources.ProxyInitLimitsMemory},
+			{Key: SidecarResourcesFieldNameProxyInitRequestsCpu, Value: secureCNCluster.SidecarsResources.ProxyInitRequestsCPU},
+			{Key: SidecarResourcesFieldNameProxyInitRequestsMemory, Value: secureCNCluster.SidecarsResources.ProxyInitRequestsMemory},
+			{Key: SidecarResourcesFieldNameProxyLimitsCpu, Value: secureCNCluster.SidecarsResources.ProxyLimitsCPU},
+			{Key: SidecarResourcesFieldNameProxyLimitsMemory, Value: secureCNCluster.SidecarsResources.ProxyLimitsMemory},
+			{Key: SidecarResourcesFieldNameProxyRequestsCpu, Value: secureCNCluster.SidecarsResources.ProxyRequestCPU},
+			{Key: SidecarResourcesFieldNameProxyRequestsMemory, Value: secureCNCluster.SidecarsResources.ProxyRequestMemory}}))
 	}
 }
 
@@ -765,13 +940,18 @@ func validateConfig(d *schema.ResourceData) error {
 
 	isMultiCluster := d.Get(MultiClusterCommunicationSupportFieldName).(bool)
 	multiClusterFolder := d.Get(MultiClusterCommunicationSupportCertsPathFieldName).(string)
+	multiClusterCerts := d.Get(MultiClusterCommunicationSupportCertsFieldName).([]interface{})
 	connectionsControl := d.Get(ConnectionsControlFieldName).(bool)
 	inspectIncomingClusterConnections := d.Get(InspectIncomingClusterConnectionsFieldName).(bool)
 	installTraceSupport := d.Get(InstallTracingSupportFieldName).(bool)
 	installEnvoyTraceSupport := d.Get(InstallEnvoyTracingSupportFieldName).(bool)
 	supportExternalTraceSource := d.Get(SupportExternalTraceSourceFieldName).(bool)
-	if isMultiCluster && multiClusterFolder == "" {
-		return errors.New(fmt.Sprintf("invalid configuration. %s can't be empty when %s is true", MultiClusterCommunicationSupportCertsPathFieldName, MultiClusterCommunicationSupportFieldName))
+	autoUpgradeControllerVersion := d.Get(AutoUpgradeControllerVersionFieldName).(bool)
+	controllerVersion := d.Get(ControllerVersionFieldName).(string)
+	installMethod := d.Get(InstallMethodFieldName).(string)
+	tokenInjection := d.Get(TokenInjectionFieldName).(bool)
+	if isMultiCluster && multiClusterFolder == "" && len(multiClusterCerts) == 0 {
+		return errors.New(fmt.Sprintf("invalid configuration. %s or %s must be set when %s is true", MultiClusterCommunicationSupportCertsPathFieldName, MultiClusterCommunicationSupportCertsFieldName, MultiClusterCommunicationSupportFieldName))
 	}
 
 	if !connectionsControl && isMultiCluster {
@@ -790,19 +970,43 @@ func validateConfig(d *schema.ResourceData) error {
 		return errors.New(fmt.Sprintf("invalid cluster api security config. %s can't be turned on when %s is off", InstallEnvoyTracingSupportFieldName, InstallTracingSupportFieldName))
 	}
 
+	if autoUpgradeControllerVersion && controllerVersion != "" {
+		return errors.New(fmt.Sprintf("invalid configuration. %s can't be set when %s is on", ControllerVersionFieldName, AutoUpgradeControllerVersionFieldName))
+	}
+
+	// the certificates of these features are generated by scripts of the bundle, which helm doesn't run
+	if installMethod == installMethodHelm && (isMultiCluster || tokenInjection || installTraceSupport) {
+		return errors.New(fmt.Sprintf("invalid configuration. %s, %s and %s are not supported when %s is %s",
+			MultiClusterCommunicationSupportFieldName, TokenInjectionFieldName, InstallTracingSupportFieldName, InstallMethodFieldName, installMethodHelm))
+	}
+
 	return nil
 }
 
 func updateAgent(ctx context.Context, d *schema.ResourceData, updatedCluster *model.KubernetesCluster, serviceApi *escherClient.MgmtServiceApiCtx, httpClientWrapper client.HttpClientWrapper) error {
-	if updatedCluster.ControllerStatus == model.ControllerStatusWAITINGFORUSERUPDATE {
+	if updatedCluster.ControllerStatus == model.ControllerStatusWAITINGFORUSERUPDATE || d.HasChange(ControllerVersionFieldName) || d.HasChange(InstallMethodFieldName) {
 		log.Print("[DEBUG] updating agent")
 		context := d.Get(KubernetesClusterContextFieldName).(string)
 		forceRemoveVault := d.Get(ForceRemoveVaultOnDeleteFieldName).(bool)
-		err := deleteAgent(context, forceRemoveVault, ctx, serviceApi, httpClientWrapper, updatedCluster.ID)
+		previousInstallMethod, installMethod := d.GetChange(InstallMethodFieldName)
+
+		// the controller is left to whoever manages it instead
+		if installMethod.(string) == installMethodNone {
+			return installAgentByInstallMethod(ctx, d, serviceApi, httpClientWrapper, updatedCluster.ID)
+		}
+
+		// a helm release is upgraded in place
+		if installMethod.(string) == installMethodHelm && previousInstallMethod.(string) == installMethodHelm {
+			return installAgentByInstallMethod(ctx, d, serviceApi, httpClientWrapper, updatedCluster.ID)
+		}
+
+		// the controller is removed with the bundle of the version it was installed with
+		previousControllerVersion, _ := d.GetChange(ControllerVersionFieldName)
+		err := deleteAgentByInstallMethod(previousInstallMethod.(string), context, forceRemoveVault, ctx, serviceApi, httpClientWrapper, updatedCluster.ID, previousControllerVersion.(string))
 		if err != nil {
 			return err
 		}
-		err = installAgentWithTimeout(ctx, serviceApi, httpClientWrapper, updatedCluster.ID, context, d.Get(MultiClusterCommunicationSupportCertsPathFieldName).(string), d.Get(InstallTracingSupportFieldName).(bool), d.Get(TokenInjectionFieldName).(bool), d.Get(SkipReadyCheckFieldName).(bool))
+		err = installAgentByInstallMethod(ctx, d, serviceApi, httpClientWrapper, updatedCluster.ID)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,150 @@
+package securecn
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"terraform-provider-securecn/internal/client"
+	utils2 "terraform-provider-securecn/internal/utils"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const clusterBundleClusterIdFieldName = "cluster_id"
+const clusterBundleControllerVersionFieldName = "controller_version"
+const clusterBundleOutputDirFieldName = "output_dir"
+const clusterBundleTriggersFieldName = "triggers"
+const clusterBundleSha256FieldName = "bundle_sha256"
+const clusterBundleFilesFieldName = "files"
+
+func ResourceClusterBundle() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceClusterBundleCreate,
+		ReadContext:   resourceClusterBundleRead,
+		DeleteContext: resourceClusterBundleDelete,
+		Description: "The installation bundle of a SecureCN k8s cluster, extracted to a local directory without installing anything on the cluster. " +
+			"Use it with a cluster whose install_method is none to apply the controller with a GitOps tool",
+		Schema: map[string]*schema.Schema{
+			clusterBundleClusterIdFieldName:         {Type: schema.TypeString, Required: true, ForceNew: true, Description: "The ID of the SecureCN k8s cluster", ValidateFunc: validation.IsUUID},
+			clusterBundleControllerVersionFieldName: {Type: schema.TypeString, Optional: true, ForceNew: true, Default: "", Description: "The controller version of the bundle, the latest version when empty"},
+			clusterBundleOutputDirFieldName:         {Type: schema.TypeString, Required: true, ForceNew: true, Description: "The local directory the bundle is extracted to", ValidateFunc: validation.StringIsNotEmpty},
+			clusterBundleTriggersFieldName:          {Type: schema.TypeMap, Optional: true, ForceNew: true, Elem: &schema.Schema{Type: schema.TypeString}, Description: "Arbitrary values that download the bundle again when changed, e.g. the settings of the cluster"},
+			clusterBundleSha256FieldName:            {Type: schema.TypeString, Computed: true, Description: "The sha256 digest of the downloaded bundle archive"},
+			clusterBundleFilesFieldName:             {Type: schema.TypeList, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}, Description: "The files extracted from the bundle, relative to the output directory"},
+		},
+	}
+}
+
+func resourceClusterBundleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Print("[DEBUG] creating cluster bundle")
+
+	httpClientWrapper := m.(client.HttpClientWrapper)
+
+	serviceApi := utils2.GetServiceApi(&httpClientWrapper)
+
+	clusterId := strfmt.UUID(d.Get(clusterBundleClusterIdFieldName).(string))
+	controllerVersion := d.Get(clusterBundleControllerVersionFieldName).(string)
+	outputDir := d.Get(clusterBundleOutputDirFieldName).(string)
+
+	buffer := new(bytes.Buffer)
+	err := serviceApi.DownloadKubernetesSecureCNBundle(ctx, httpClientWrapper.HttpClient, buffer, clusterId, controllerVersion)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	digest := sha256.Sum256(buffer.Bytes())
+	files, err := getClusterBundleFiles(buffer.Bytes())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = os.MkdirAll(outputDir, 0755)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = utils2.ExtractTarGz(buffer, outputDir)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(string(clusterId))
+	_ = d.Set(clusterBundleSha256FieldName, hex.EncodeToString(digest[:]))
+	_ = d.Set(clusterBundleFilesFieldName, files)
+
+	return resourceClusterBundleRead(ctx, d, m)
+}
+
+func resourceClusterBundleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Print("[DEBUG] reading cluster bundle")
+
+	outputDir := d.Get(clusterBundleOutputDirFieldName).(string)
+	for _, file := range d.Get(clusterBundleFilesFieldName).([]interface{}) {
+		_, err := os.Stat(filepath.Join(outputDir, file.(string)))
+		if os.IsNotExist(err) {
+			log.Printf("[DEBUG] bundle file %s was removed", file)
+			// Tell terraform the bundle doesn't exist
+			d.SetId("")
+			return nil
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceClusterBundleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Print("[DEBUG] deleting cluster bundle")
+
+	// only the extracted files are removed, the output directory may hold other files
+	outputDir := d.Get(clusterBundleOutputDirFieldName).(string)
+	for _, file := range d.Get(clusterBundleFilesFieldName).([]interface{}) {
+		err := os.Remove(filepath.Join(outputDir, file.(string)))
+		if err != nil && !os.IsNotExist(err) {
+			return diag.FromErr(err)
+		}
+	}
+
+	// Tell terraform the bundle doesn't exist
+	d.SetId("")
+
+	return nil
+}
+
+func getClusterBundleFiles(bundle []byte) ([]string, error) {
+	uncompressedStream, err := gzip.NewReader(bytes.NewReader(bundle))
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	tarReader := tar.NewReader(uncompressedStream)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Typeflag == tar.TypeReg {
+			files = append(files, filepath.Clean(header.Name))
+		}
+	}
+
+	sort.Strings(files)
+	return files, nil
+}
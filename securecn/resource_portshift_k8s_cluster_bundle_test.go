@@ -0,0 +1,55 @@
+package securecn
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestClusterBundleLifecycle(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	clusterId := api.addCluster("production")
+	outputDir := t.TempDir()
+	lifecycle := newTestResourceLifecycle(ResourceClusterBundle(), api.client())
+
+	state := lifecycle.apply(t, map[string]interface{}{
+		clusterBundleClusterIdFieldName: clusterId,
+		clusterBundleOutputDirFieldName: outputDir,
+	})
+	if state.Attributes[clusterBundleFilesFieldName+".#"] != "1" || state.Attributes[clusterBundleSha256FieldName] == "" {
+		t.Fatalf("unexpected bundle state: %v", state.Attributes)
+	}
+
+	manifest := filepath.Join(outputDir, state.Attributes[clusterBundleFilesFieldName+".0"])
+	if _, err := os.Stat(manifest); err != nil {
+		t.Fatalf("expected the bundle to be extracted: %s", err)
+	}
+
+	// a removed bundle file is downloaded again
+	err := os.Remove(manifest)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if refreshed := lifecycle.refresh(t, state); refreshed != nil {
+		t.Fatalf("expected the bundle to be gone after its files were removed")
+	}
+	lifecycle.state = nil
+	lifecycle.apply(t, map[string]interface{}{
+		clusterBundleClusterIdFieldName: clusterId,
+		clusterBundleOutputDirFieldName: outputDir,
+	})
+
+	otherFile := filepath.Join(outputDir, "kustomization.yaml")
+	err = os.WriteFile(otherFile, []byte("resources: []\n"), 0644)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	lifecycle.destroy(t)
+	if _, err := os.Stat(manifest); !os.IsNotExist(err) {
+		t.Fatalf("expected the bundle files to be removed, got: %v", err)
+	}
+	if _, err := os.Stat(otherFile); err != nil {
+		t.Fatalf("expected the other files of the output directory to be kept: %s", err)
+	}
+}
@@ -0,0 +1,358 @@
+package securecn
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"terraform-provider-securecn/internal/escher_api/model"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+)
+
+const testClusterContext = "kind-production"
+
+func getTestClusterConfig(config map[string]interface{}) map[string]interface{} {
+	rawConfig := map[string]interface{}{
+		KubernetesClusterContextFieldName: testClusterContext,
+		NameFieldName:                     "production",
+	}
+	for key, value := range config {
+		rawConfig[key] = value
+	}
+
+	return rawConfig
+}
+
+func assertFakeInstallerCalls(t *testing.T, installer *fakeAgentInstaller, expected ...fakeAgentInstallerCall) {
+	t.Helper()
+
+	calls := installer.takeCalls()
+	actual := make([]string, 0, len(calls))
+	for _, call := range calls {
+		actual = append(actual, call.method+" "+call.installation.installMethod+" "+call.installation.controllerVersion)
+	}
+	expectedCalls := make([]string, 0, len(expected))
+	for _, call := range expected {
+		expectedCalls = append(expectedCalls, call.method+" "+call.installation.installMethod+" "+call.installation.controllerVersion)
+	}
+
+	if !reflect.DeepEqual(actual, expectedCalls) {
+		t.Fatalf("expected installer calls %v, got %v", expectedCalls, actual)
+	}
+}
+
+func fakeInstallerCall(method string, installMethod string, controllerVersion string) fakeAgentInstallerCall {
+	return fakeAgentInstallerCall{method: method, installation: agentInstallation{installMethod: installMethod, controllerVersion: controllerVersion}}
+}
+
+func TestClusterLifecycle(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	installer := useFakeAgentInstaller(t)
+	lifecycle := newTestResourceLifecycle(ResourceCluster(), api.client())
+
+	state := lifecycle.apply(t, getTestClusterConfig(nil))
+	assertFakeInstallerCalls(t, installer, fakeInstallerCall(fakeInstallerInstall, installMethodScript, ""))
+	if installation, ok := installer.installation(testClusterContext); !ok || string(installation.clusterId) != state.ID {
+		t.Fatalf("expected the controller of cluster %s to be installed, got %v", state.ID, installation)
+	}
+
+	// the controller is reinstalled with the bundle of the new version, after it is removed with the bundle of the old one
+	lifecycle.apply(t, getTestClusterConfig(map[string]interface{}{ControllerVersionFieldName: "1.2.3"}))
+	assertFakeInstallerCalls(t, installer,
+		fakeInstallerCall(fakeInstallerUninstall, installMethodScript, ""),
+		fakeInstallerCall(fakeInstallerInstall, installMethodScript, "1.2.3"))
+
+	state = lifecycle.apply(t, getTestClusterConfig(map[string]interface{}{ControllerVersionFieldName: "1.2.3", InstallMethodFieldName: installMethodHelm}))
+	assertFakeInstallerCalls(t, installer,
+		fakeInstallerCall(fakeInstallerUninstall, installMethodScript, "1.2.3"),
+		fakeInstallerCall(fakeInstallerInstall, installMethodHelm, "1.2.3"))
+	if state.Attributes[HelmReleaseRevisionFieldName] != "1" || state.Attributes[HelmValuesDigestFieldName] == "" {
+		t.Fatalf("expected the helm release to be kept in the state: %v", state.Attributes)
+	}
+
+	// a helm release is upgraded in place
+	state = lifecycle.apply(t, getTestClusterConfig(map[string]interface{}{ControllerVersionFieldName: "1.2.4", InstallMethodFieldName: installMethodHelm}))
+	assertFakeInstallerCalls(t, installer, fakeInstallerCall(fakeInstallerInstall, installMethodHelm, "1.2.4"))
+	if state.Attributes[HelmReleaseRevisionFieldName] != "2" {
+		t.Fatalf("expected helm release revision 2, got %s", state.Attributes[HelmReleaseRevisionFieldName])
+	}
+
+	// settings that aren't part of the installation don't touch the controller
+	lifecycle.apply(t, getTestClusterConfig(map[string]interface{}{ControllerVersionFieldName: "1.2.4", InstallMethodFieldName: installMethodHelm, FailCloseFieldName: true}))
+	assertFakeInstallerCalls(t, installer)
+
+	// unless Panoptica waits for the user to update the controller
+	api.setServerFields(fakeApiClusters, state.ID, map[string]interface{}{"controllerStatus": string(model.ControllerStatusWAITINGFORUSERUPDATE)})
+	lifecycle.apply(t, getTestClusterConfig(map[string]interface{}{ControllerVersionFieldName: "1.2.4", InstallMethodFieldName: installMethodHelm}))
+	assertFakeInstallerCalls(t, installer, fakeInstallerCall(fakeInstallerInstall, installMethodHelm, "1.2.4"))
+	api.setServerFields(fakeApiClusters, state.ID, nil)
+
+	lifecycle.destroy(t)
+	assertFakeInstallerCalls(t, installer, fakeInstallerCall(fakeInstallerUninstall, installMethodHelm, "1.2.4"))
+	if ids := api.objectIds(fakeApiClusters); len(ids) != 0 {
+		t.Fatalf("expected the cluster to be deleted, got %v", ids)
+	}
+}
+
+func TestClusterWithoutInstallation(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	installer := useFakeAgentInstaller(t)
+	lifecycle := newTestResourceLifecycle(ResourceCluster(), api.client())
+
+	lifecycle.apply(t, getTestClusterConfig(map[string]interface{}{InstallMethodFieldName: installMethodNone}))
+	lifecycle.apply(t, getTestClusterConfig(map[string]interface{}{InstallMethodFieldName: installMethodNone, ControllerVersionFieldName: "1.2.3"}))
+	lifecycle.destroy(t)
+
+	assertFakeInstallerCalls(t, installer)
+}
+
+func TestClusterCreateRollsBackOnInstallationFailure(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	installer := useFakeAgentInstaller(t)
+	installer.set(func(installer *fakeAgentInstaller) {
+		installer.installErr = errFakeInstallFailed
+		// a failed rollback must not hide the installation error
+		installer.uninstallErr = errFakeUninstallFailed
+	})
+	lifecycle := newTestResourceLifecycle(ResourceCluster(), api.client())
+
+	_, diags := lifecycle.tryApply(getTestClusterConfig(nil))
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, errFakeInstallFailed.Error()) {
+		t.Fatalf("expected the installation error, got %v", diags)
+	}
+
+	assertFakeInstallerCalls(t, installer,
+		fakeInstallerCall(fakeInstallerInstall, installMethodScript, ""),
+		fakeInstallerCall(fakeInstallerUninstall, installMethodScript, ""))
+	if ids := api.objectIds(fakeApiClusters); len(ids) != 0 {
+		t.Fatalf("expected the cluster to be deleted on rollback, got %v", ids)
+	}
+}
+
+func TestClusterCreateRollsBackOnInstallationTimeout(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	installer := useFakeAgentInstaller(t)
+	installer.set(func(installer *fakeAgentInstaller) { installer.hangInstall = true })
+	lifecycle := newTestResourceLifecycle(ResourceCluster(), api.client())
+
+	_, diags := lifecycle.tryApply(getTestClusterConfig(nil))
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "timed out") {
+		t.Fatalf("expected the installation to time out, got %v", diags)
+	}
+
+	assertFakeInstallerCalls(t, installer,
+		fakeInstallerCall(fakeInstallerInstall, installMethodScript, ""),
+		fakeInstallerCall(fakeInstallerUninstall, installMethodScript, ""))
+	if ids := api.objectIds(fakeApiClusters); len(ids) != 0 {
+		t.Fatalf("expected the cluster to be deleted on rollback, got %v", ids)
+	}
+}
+
+func TestClusterInstallationStopsWhenContextIsCancelled(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	installer := useFakeAgentInstaller(t)
+	installer.set(func(installer *fakeAgentInstaller) { installer.hangInstall = true })
+	// the fake installer puts the timeout back when the test ends
+	agentInstallationTimeout = time.Minute
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+	defer cancel()
+
+	httpClientWrapper := api.client()
+	err := installAgentWithTimeout(ctx, httpClientWrapper.EscherClient, httpClientWrapper, agentInstallation{
+		clusterId:     strfmt.UUID(uuid.New().String()),
+		k8sContext:    testClusterContext,
+		installMethod: installMethodScript,
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the installation to stop with its context, got: %v", err)
+	}
+}
+
+func TestClusterCreateWithoutRollbackKeepsCluster(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	installer := useFakeAgentInstaller(t)
+	installer.set(func(installer *fakeAgentInstaller) { installer.installErr = errFakeInstallFailed })
+	lifecycle := newTestResourceLifecycle(ResourceCluster(), api.client())
+
+	state := lifecycle.apply(t, getTestClusterConfig(map[string]interface{}{RollbackOnControllerFailureFieldName: false}))
+
+	assertFakeInstallerCalls(t, installer, fakeInstallerCall(fakeInstallerInstall, installMethodScript, ""))
+	if api.object(fakeApiClusters, state.ID) == nil {
+		t.Fatalf("expected the cluster to be kept for debugging")
+	}
+}
+
+func TestClusterUpdateKeepsControllerWhenUninstallFails(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	installer := useFakeAgentInstaller(t)
+	lifecycle := newTestResourceLifecycle(ResourceCluster(), api.client())
+
+	lifecycle.apply(t, getTestClusterConfig(nil))
+	installer.takeCalls()
+
+	installer.set(func(installer *fakeAgentInstaller) { installer.uninstallErr = errFakeUninstallFailed })
+	_, diags := lifecycle.tryApply(getTestClusterConfig(map[string]interface{}{ControllerVersionFieldName: "1.2.3"}))
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, errFakeUninstallFailed.Error()) {
+		t.Fatalf("expected the uninstallation error, got %v", diags)
+	}
+
+	assertFakeInstallerCalls(t, installer, fakeInstallerCall(fakeInstallerUninstall, installMethodScript, ""))
+	if installation, ok := installer.installation(testClusterContext); !ok || installation.controllerVersion != "" {
+		t.Fatalf("expected the previous controller to stay installed, got %v", installation)
+	}
+}
+
+func TestClusterControllerVersionPinUpgradeAndDowngrade(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	installer := useFakeAgentInstaller(t)
+	lifecycle := newTestResourceLifecycle(ResourceCluster(), api.client())
+
+	lifecycle.apply(t, getTestClusterConfig(map[string]interface{}{ControllerVersionFieldName: "1.2.3"}))
+	assertFakeInstallerCalls(t, installer, fakeInstallerCall(fakeInstallerInstall, installMethodScript, "1.2.3"))
+
+	// a pinned version isn't upgraded while it stays pinned
+	lifecycle.apply(t, getTestClusterConfig(map[string]interface{}{ControllerVersionFieldName: "1.2.3", FailCloseFieldName: true}))
+	assertFakeInstallerCalls(t, installer)
+
+	lifecycle.apply(t, getTestClusterConfig(map[string]interface{}{ControllerVersionFieldName: "1.3.0"}))
+	assertFakeInstallerCalls(t, installer,
+		fakeInstallerCall(fakeInstallerUninstall, installMethodScript, "1.2.3"),
+		fakeInstallerCall(fakeInstallerInstall, installMethodScript, "1.3.0"))
+
+	lifecycle.apply(t, getTestClusterConfig(map[string]interface{}{ControllerVersionFieldName: "1.2.3"}))
+	assertFakeInstallerCalls(t, installer,
+		fakeInstallerCall(fakeInstallerUninstall, installMethodScript, "1.3.0"),
+		fakeInstallerCall(fakeInstallerInstall, installMethodScript, "1.2.3"))
+	if installation, ok := installer.installation(testClusterContext); !ok || installation.controllerVersion != "1.2.3" {
+		t.Fatalf("expected the controller to be downgraded to 1.2.3, got %v", installation)
+	}
+
+	// unpinning installs the latest version
+	lifecycle.apply(t, getTestClusterConfig(nil))
+	assertFakeInstallerCalls(t, installer,
+		fakeInstallerCall(fakeInstallerUninstall, installMethodScript, "1.2.3"),
+		fakeInstallerCall(fakeInstallerInstall, installMethodScript, ""))
+
+	lifecycle.destroy(t)
+	assertFakeInstallerCalls(t, installer, fakeInstallerCall(fakeInstallerUninstall, installMethodScript, ""))
+}
+
+func TestClusterHelmControllerVersionDowngradeInPlace(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	installer := useFakeAgentInstaller(t)
+	lifecycle := newTestResourceLifecycle(ResourceCluster(), api.client())
+
+	lifecycle.apply(t, getTestClusterConfig(map[string]interface{}{ControllerVersionFieldName: "1.3.0", InstallMethodFieldName: installMethodHelm}))
+	installer.takeCalls()
+
+	state := lifecycle.apply(t, getTestClusterConfig(map[string]interface{}{ControllerVersionFieldName: "1.2.3", InstallMethodFieldName: installMethodHelm}))
+	assertFakeInstallerCalls(t, installer, fakeInstallerCall(fakeInstallerInstall, installMethodHelm, "1.2.3"))
+	if state.Attributes[HelmReleaseRevisionFieldName] != "2" {
+		t.Fatalf("expected the helm release to be downgraded to revision 2, got %s", state.Attributes[HelmReleaseRevisionFieldName])
+	}
+
+	lifecycle.destroy(t)
+	assertFakeInstallerCalls(t, installer, fakeInstallerCall(fakeInstallerUninstall, installMethodHelm, "1.2.3"))
+}
+
+func TestClusterControllerVersionConflictsWithAutoUpgrade(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	installer := useFakeAgentInstaller(t)
+	lifecycle := newTestResourceLifecycle(ResourceCluster(), api.client())
+
+	conflictingConfig := getTestClusterConfig(map[string]interface{}{ControllerVersionFieldName: "1.2.3", AutoUpgradeControllerVersionFieldName: true})
+	_, diags := lifecycle.tryApply(conflictingConfig)
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, ControllerVersionFieldName) || !strings.Contains(diags[0].Summary, AutoUpgradeControllerVersionFieldName) {
+		t.Fatalf("expected the conflict to be reported, got %v", diags)
+	}
+	assertFakeInstallerCalls(t, installer)
+	if ids := api.objectIds(fakeApiClusters); len(ids) != 0 {
+		t.Fatalf("expected no cluster to be created, got %v", ids)
+	}
+
+	// pinning the version of an auto upgraded controller is rejected before the controller is touched
+	state := lifecycle.apply(t, getTestClusterConfig(map[string]interface{}{AutoUpgradeControllerVersionFieldName: true}))
+	installer.takeCalls()
+
+	_, diags = lifecycle.tryApply(conflictingConfig)
+	if !diags.HasError() {
+		t.Fatal("expected the conflict to be reported on update")
+	}
+	assertFakeInstallerCalls(t, installer)
+	if cluster := api.object(fakeApiClusters, state.ID); cluster["autoUpgradeControllerVersion"] != true {
+		t.Fatalf("expected the cluster to be left as it was, got %v", cluster)
+	}
+}
+
+func TestClusterVersionChangeUninstallsWithPreviousVersionBundle(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	installer := useFakeAgentInstaller(t)
+	lifecycle := newTestResourceLifecycle(ResourceCluster(), api.client())
+
+	lifecycle.apply(t, getTestClusterConfig(map[string]interface{}{ControllerVersionFieldName: "1.2.3"}))
+	installer.takeCalls()
+
+	// the installed controller is removed with the bundle of its own version, even when the new version fails to install
+	installer.set(func(installer *fakeAgentInstaller) { installer.installErr = errFakeInstallFailed })
+	_, diags := lifecycle.tryApply(getTestClusterConfig(map[string]interface{}{ControllerVersionFieldName: "1.3.0"}))
+	if !diags.HasError() {
+		t.Fatal("expected the installation error")
+	}
+	assertFakeInstallerCalls(t, installer,
+		fakeInstallerCall(fakeInstallerUninstall, installMethodScript, "1.2.3"),
+		fakeInstallerCall(fakeInstallerInstall, installMethodScript, "1.3.0"))
+
+	// switching the install method removes the script installation with the bundle of its version too
+	installer.set(func(installer *fakeAgentInstaller) { installer.installErr = nil })
+	lifecycle.apply(t, getTestClusterConfig(map[string]interface{}{ControllerVersionFieldName: "1.3.0"}))
+	installer.takeCalls()
+	lifecycle.apply(t, getTestClusterConfig(map[string]interface{}{ControllerVersionFieldName: "1.4.0", InstallMethodFieldName: installMethodHelm}))
+	assertFakeInstallerCalls(t, installer,
+		fakeInstallerCall(fakeInstallerUninstall, installMethodScript, "1.3.0"),
+		fakeInstallerCall(fakeInstallerInstall, installMethodHelm, "1.4.0"))
+}
+
+func TestClusterDeleteOfUnreachableCluster(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	installer := useFakeAgentInstaller(t)
+
+	config := getTestClusterConfig(map[string]interface{}{IgnoreUnreachableClusterOnDeleteFieldName: true})
+	lifecycle := newTestResourceLifecycle(ResourceCluster(), api.client())
+	state := lifecycle.apply(t, config)
+	installer.takeCalls()
+
+	// the cluster is kept while the controller can't be uninstalled from a reachable cluster
+	installer.set(func(installer *fakeAgentInstaller) { installer.uninstallErr = errFakeUninstallFailed })
+	diags := lifecycle.tryDestroy()
+	if !diags.HasError() || api.object(fakeApiClusters, state.ID) == nil {
+		t.Fatalf("expected the delete to fail and keep the cluster, got %v", diags)
+	}
+
+	installer.set(func(installer *fakeAgentInstaller) { installer.unreachable = true })
+	lifecycle.destroy(t)
+	if ids := api.objectIds(fakeApiClusters); len(ids) != 0 {
+		t.Fatalf("expected the cluster to be deleted, got %v", ids)
+	}
+}
+
+func TestClusterDeleteSkipsUninstall(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	installer := useFakeAgentInstaller(t)
+	lifecycle := newTestResourceLifecycle(ResourceCluster(), api.client())
+
+	lifecycle.apply(t, getTestClusterConfig(map[string]interface{}{SkipUninstallOnDeleteFieldName: true}))
+	installer.takeCalls()
+
+	lifecycle.destroy(t)
+	assertFakeInstallerCalls(t, installer)
+	if _, ok := installer.installation(testClusterContext); !ok {
+		t.Fatalf("expected the controller to stay installed")
+	}
+}
@@ -0,0 +1,159 @@
+package securecn
+
+import (
+	"context"
+	"log"
+	"time"
+
+	utils2 "terraform-provider-securecn/internal/utils"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const multiClusterCACommonNameFieldName = "common_name"
+const multiClusterCAValidityDaysFieldName = "validity_days"
+const multiClusterCAClusterNamesFieldName = "cluster_names"
+const multiClusterCARootCertFieldName = "root_cert_pem"
+const multiClusterCARootKeyFieldName = "root_key_pem"
+const multiClusterCAClusterCertsFieldName = "cluster_certificates"
+const multiClusterCAClusterNameFieldName = "cluster_name"
+const multiClusterCACACertFieldName = "ca_cert_pem"
+const multiClusterCACAKeyFieldName = "ca_key_pem"
+const multiClusterCACertChainFieldName = "cert_chain_pem"
+
+func ResourceMultiClusterCA() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMultiClusterCACreate,
+		ReadContext:   resourceMultiClusterCARead,
+		UpdateContext: resourceMultiClusterCAUpdate,
+		DeleteContext: resourceMultiClusterCADelete,
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+			if d.HasChange(multiClusterCAClusterNamesFieldName) {
+				return d.SetNewComputed(multiClusterCAClusterCertsFieldName)
+			}
+			return nil
+		},
+		Description: "A root CA and per cluster intermediate CAs for SecureCN multi cluster communication, kept in the state. " +
+			"The certificates of a cluster are used through the multi_cluster_communication_support_certs block of securecn_k8s_cluster",
+		Schema: map[string]*schema.Schema{
+			multiClusterCACommonNameFieldName:   {Type: schema.TypeString, Optional: true, ForceNew: true, Default: "SecureCN Multi Cluster Root CA", Description: "The common name of the root CA"},
+			multiClusterCAValidityDaysFieldName: {Type: schema.TypeInt, Optional: true, ForceNew: true, Default: 3650, Description: "The number of days the CAs are valid for", ValidateFunc: validation.IntAtLeast(1)},
+			multiClusterCAClusterNamesFieldName: {Type: schema.TypeSet, Required: true, MinItems: 1, Elem: &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsNotEmpty}, Description: "The names of the clusters to issue intermediate CAs for"},
+			multiClusterCARootCertFieldName:     {Type: schema.TypeString, Computed: true, Description: "The PEM encoded root CA certificate"},
+			multiClusterCARootKeyFieldName:      {Type: schema.TypeString, Computed: true, Sensitive: true, Description: "The PEM encoded root CA private key"},
+			multiClusterCAClusterCertsFieldName: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The intermediate CA of each cluster, identified by its cluster_name",
+				Set:         hashMultiClusterCAClusterCertificate,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						multiClusterCAClusterNameFieldName: {Type: schema.TypeString, Computed: true, Description: "The name of the cluster"},
+						multiClusterCACACertFieldName:      {Type: schema.TypeString, Computed: true, Description: "The PEM encoded intermediate CA certificate of the cluster"},
+						multiClusterCACAKeyFieldName:       {Type: schema.TypeString, Computed: true, Description: "The PEM encoded intermediate CA private key of the cluster"},
+						multiClusterCACertChainFieldName:   {Type: schema.TypeString, Computed: true, Description: "The PEM encoded certificate chain of the cluster, from the intermediate CA to the root CA"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceMultiClusterCACreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Print("[DEBUG] creating multi cluster CA")
+
+	rootCert, rootKey, err := utils2.GenerateRootCA(d.Get(multiClusterCACommonNameFieldName).(string), getMultiClusterCAValidity(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set(multiClusterCARootCertFieldName, rootCert)
+	_ = d.Set(multiClusterCARootKeyFieldName, rootKey)
+
+	err = updateMultiClusterCAClusterCertificates(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(uuid.New().String())
+
+	return nil
+}
+
+func resourceMultiClusterCARead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Print("[DEBUG] reading multi cluster CA")
+
+	// the CAs live only in the state
+	return nil
+}
+
+func resourceMultiClusterCAUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Print("[DEBUG] updating multi cluster CA")
+
+	err := updateMultiClusterCAClusterCertificates(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceMultiClusterCADelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Print("[DEBUG] deleting multi cluster CA")
+
+	// Tell terraform the CA doesn't exist
+	d.SetId("")
+
+	return nil
+}
+
+// updateMultiClusterCAClusterCertificates issues intermediate CAs for added clusters and drops removed ones,
+// the intermediate CAs of the remaining clusters are kept as is.
+func updateMultiClusterCAClusterCertificates(d *schema.ResourceData) error {
+	rootCert := d.Get(multiClusterCARootCertFieldName).(string)
+	rootKey := d.Get(multiClusterCARootKeyFieldName).(string)
+
+	existingCerts := map[string]map[string]interface{}{}
+	previousCerts, _ := d.GetChange(multiClusterCAClusterCertsFieldName)
+	for _, clusterCert := range previousCerts.(*schema.Set).List() {
+		clusterCertMap := clusterCert.(map[string]interface{})
+		existingCerts[clusterCertMap[multiClusterCAClusterNameFieldName].(string)] = clusterCertMap
+	}
+
+	var clusterCerts []interface{}
+	for _, clusterNameValue := range d.Get(multiClusterCAClusterNamesFieldName).(*schema.Set).List() {
+		clusterName := clusterNameValue.(string)
+		if clusterCert, ok := existingCerts[clusterName]; ok {
+			clusterCerts = append(clusterCerts, clusterCert)
+			continue
+		}
+
+		caCert, caKey, err := utils2.GenerateIntermediateCA(rootCert, rootKey, clusterName+" Intermediate CA", getMultiClusterCAValidity(d))
+		if err != nil {
+			return err
+		}
+
+		clusterCerts = append(clusterCerts, map[string]interface{}{
+			multiClusterCAClusterNameFieldName: clusterName,
+			multiClusterCACACertFieldName:      caCert,
+			multiClusterCACAKeyFieldName:       caKey,
+			multiClusterCACertChainFieldName:   caCert + rootCert,
+		})
+	}
+
+	return d.Set(multiClusterCAClusterCertsFieldName, clusterCerts)
+}
+
+// hashMultiClusterCAClusterCertificate identifies the certificates of a cluster by its name, so adding or removing
+// clusters doesn't move the certificates of the others
+func hashMultiClusterCAClusterCertificate(clusterCert interface{}) int {
+	return schema.HashString(clusterCert.(map[string]interface{})[multiClusterCAClusterNameFieldName])
+}
+
+func getMultiClusterCAValidity(d *schema.ResourceData) time.Duration {
+	return time.Duration(d.Get(multiClusterCAValidityDaysFieldName).(int)) * 24 * time.Hour
+}
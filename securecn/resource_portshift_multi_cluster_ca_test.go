@@ -0,0 +1,65 @@
+package securecn
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// getTestClusterCertificates returns the attributes of the certificates in a multi cluster CA state by cluster name
+func getTestClusterCertificates(state *terraform.InstanceState) map[string]map[string]string {
+	certsByHash := map[string]map[string]string{}
+	prefix := multiClusterCAClusterCertsFieldName + "."
+	for key, value := range state.Attributes {
+		parts := strings.SplitN(strings.TrimPrefix(key, prefix), ".", 2)
+		if !strings.HasPrefix(key, prefix) || len(parts) != 2 {
+			continue
+		}
+		if certsByHash[parts[0]] == nil {
+			certsByHash[parts[0]] = map[string]string{}
+		}
+		certsByHash[parts[0]][parts[1]] = value
+	}
+
+	certs := map[string]map[string]string{}
+	for _, cert := range certsByHash {
+		certs[cert[multiClusterCAClusterNameFieldName]] = cert
+	}
+
+	return certs
+}
+
+func TestMultiClusterCALifecycle(t *testing.T) {
+	lifecycle := newTestResourceLifecycle(ResourceMultiClusterCA(), nil)
+
+	state := lifecycle.apply(t, map[string]interface{}{
+		multiClusterCAClusterNamesFieldName: []interface{}{"east", "west"},
+	})
+	certs := getTestClusterCertificates(state)
+	if len(certs) != 2 || certs["west"][multiClusterCACACertFieldName] == "" || state.Attributes[multiClusterCARootCertFieldName] == "" {
+		t.Fatalf("unexpected CA state: %v", state.Attributes)
+	}
+	westCert := certs["west"][multiClusterCACACertFieldName]
+	westKeyAttribute := multiClusterCAClusterCertsFieldName + "." + strconv.Itoa(hashMultiClusterCAClusterCertificate(map[string]interface{}{multiClusterCAClusterNameFieldName: "west"})) + "." + multiClusterCACAKeyFieldName
+	westKey := state.Attributes[westKeyAttribute]
+	rootCert := state.Attributes[multiClusterCARootCertFieldName]
+
+	// north sorts before west, the certificates of west are still found under the same key
+	updatedState := lifecycle.apply(t, map[string]interface{}{
+		multiClusterCAClusterNamesFieldName: []interface{}{"west", "north"},
+	})
+	if updatedState.ID != state.ID || updatedState.Attributes[multiClusterCARootCertFieldName] != rootCert {
+		t.Fatalf("expected the root CA to be kept")
+	}
+	updatedCerts := getTestClusterCertificates(updatedState)
+	if len(updatedCerts) != 2 || updatedCerts["north"][multiClusterCACACertFieldName] == "" || updatedCerts["west"][multiClusterCACACertFieldName] != westCert {
+		t.Fatalf("expected the intermediate CA of west to be kept and one to be issued for north: %v", updatedState.Attributes)
+	}
+	if westKey == "" || updatedState.Attributes[westKeyAttribute] != westKey {
+		t.Fatalf("expected the key of west to stay under the same address: %v", updatedState.Attributes)
+	}
+
+	lifecycle.destroy(t)
+}
@@ -0,0 +1,389 @@
+package securecn
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"terraform-provider-securecn/internal/client"
+	"terraform-provider-securecn/internal/escher_api/escherClient"
+	model2 "terraform-provider-securecn/internal/escher_api/model"
+	utils2 "terraform-provider-securecn/internal/utils"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const pspProfilePrivilegedFieldName = "privileged"
+const pspProfileAllowPrivilegeEscalationFieldName = "allow_privilege_escalation"
+const pspProfileHostNetworkFieldName = "host_network"
+const pspProfileHostPIDFieldName = "host_pid"
+const pspProfileHostIPCFieldName = "host_ipc"
+const pspProfileReadOnlyRootFilesystemFieldName = "read_only_root_filesystem"
+const pspProfileAllowedCapabilitiesFieldName = "allowed_capabilities"
+const pspProfileDefaultAddCapabilitiesFieldName = "default_add_capabilities"
+const pspProfileRequiredDropCapabilitiesFieldName = "required_drop_capabilities"
+const pspProfileVolumesFieldName = "volumes"
+const pspProfileHostPortFieldName = "host_port"
+const pspProfileRunAsUserFieldName = "run_as_user"
+const pspProfileRunAsGroupFieldName = "run_as_group"
+const pspProfileSupplementalGroupsFieldName = "supplemental_groups"
+const pspProfileFsGroupFieldName = "fs_group"
+const pspProfileRuleFieldName = "rule"
+const pspProfileRangeFieldName = "range"
+const pspProfileMinFieldName = "min"
+const pspProfileMaxFieldName = "max"
+
+var pspProfileVolumeTypes = []string{"*", "azureDisk", "azureFile", "cephFS", "cinder", "configMap", "csi", "downwardAPI",
+	"emptyDir", "ephemeral", "fc", "flexVolume", "flocker", "gcePersistentDisk", "gitRepo", "glusterfs", "hostPath", "iscsi",
+	"nfs", "persistentVolumeClaim", "photonPersistentDisk", "portworxVolume", "projected", "quobyte", "rbd", "scaleIO",
+	"secret", "storageos", "vsphereVolume"}
+
+// the rules each id range strategy accepts, as in the kubernetes PodSecurityPolicy
+var pspProfileIDRangeStrategyRules = map[string][]string{
+	pspProfileRunAsUserFieldName:          {model2.PodSecurityPolicyIDRangeStrategyRuleMustRunAs, model2.PodSecurityPolicyIDRangeStrategyRuleMustRunAsNonRoot, model2.PodSecurityPolicyIDRangeStrategyRuleRunAsAny},
+	pspProfileRunAsGroupFieldName:         {model2.PodSecurityPolicyIDRangeStrategyRuleMustRunAs, model2.PodSecurityPolicyIDRangeStrategyRuleMayRunAs, model2.PodSecurityPolicyIDRangeStrategyRuleRunAsAny},
+	pspProfileSupplementalGroupsFieldName: {model2.PodSecurityPolicyIDRangeStrategyRuleMustRunAs, model2.PodSecurityPolicyIDRangeStrategyRuleMayRunAs, model2.PodSecurityPolicyIDRangeStrategyRuleRunAsAny},
+	pspProfileFsGroupFieldName:            {model2.PodSecurityPolicyIDRangeStrategyRuleMustRunAs, model2.PodSecurityPolicyIDRangeStrategyRuleMayRunAs, model2.PodSecurityPolicyIDRangeStrategyRuleRunAsAny},
+}
+
+func ResourcePodSecurityPolicyProfile() *schema.Resource {
+
+	return &schema.Resource{
+		CreateContext: resourcePodSecurityPolicyProfileCreate,
+		ReadContext:   resourcePodSecurityPolicyProfileRead,
+		UpdateContext: resourcePodSecurityPolicyProfileUpdate,
+		DeleteContext: resourcePodSecurityPolicyProfileDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Description: "A SecureCN pod security policy profile, referenced by name from the psp_profile of deployment rules",
+		Schema: map[string]*schema.Schema{
+			nameFieldName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			descriptionFieldName: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			pspProfilePrivilegedFieldName:               {Type: schema.TypeBool, Optional: true, Default: false, Description: "Allow privileged containers"},
+			pspProfileAllowPrivilegeEscalationFieldName: {Type: schema.TypeBool, Optional: true, Default: false, Description: "Allow a process to gain more privileges than its parent process"},
+			pspProfileHostNetworkFieldName:              {Type: schema.TypeBool, Optional: true, Default: false, Description: "Allow pods to use the network namespace of the host"},
+			pspProfileHostPIDFieldName:                  {Type: schema.TypeBool, Optional: true, Default: false, Description: "Allow pods to use the process id namespace of the host"},
+			pspProfileHostIPCFieldName:                  {Type: schema.TypeBool, Optional: true, Default: false, Description: "Allow pods to use the IPC namespace of the host"},
+			pspProfileReadOnlyRootFilesystemFieldName:   {Type: schema.TypeBool, Optional: true, Default: false, Description: "Require containers to run with a read only root filesystem"},
+			pspProfileAllowedCapabilitiesFieldName: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsNotEmpty},
+				Description: "The capabilities containers may add, * allows any capability",
+			},
+			pspProfileDefaultAddCapabilitiesFieldName: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsNotEmpty},
+				Description: "The capabilities added to containers by default",
+			},
+			pspProfileRequiredDropCapabilitiesFieldName: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsNotEmpty},
+				Description: "The capabilities containers must drop",
+			},
+			pspProfileVolumesFieldName: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringInSlice(pspProfileVolumeTypes, false)},
+				Description: "The volume types pods may use, * allows any volume type",
+			},
+			pspProfileHostPortFieldName: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        getPspProfileRangeSchema(0, 65535),
+				Description: "The host port ranges pods may use",
+			},
+			pspProfileRunAsUserFieldName:          getPspProfileIDRangeStrategySchema(pspProfileRunAsUserFieldName, "The user ids containers may run as"),
+			pspProfileRunAsGroupFieldName:         getPspProfileIDRangeStrategySchema(pspProfileRunAsGroupFieldName, "The primary group ids containers may run as"),
+			pspProfileSupplementalGroupsFieldName: getPspProfileIDRangeStrategySchema(pspProfileSupplementalGroupsFieldName, "The supplemental group ids pods may use"),
+			pspProfileFsGroupFieldName:            getPspProfileIDRangeStrategySchema(pspProfileFsGroupFieldName, "The group ids that may own the volumes of pods"),
+		},
+	}
+}
+
+func getPspProfileRangeSchema(min int, max int) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			pspProfileMinFieldName: {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntBetween(min, max), Description: "The first id or port of the range"},
+			pspProfileMaxFieldName: {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntBetween(min, max), Description: "The last id or port of the range"},
+		},
+	}
+}
+
+func getPspProfileIDRangeStrategySchema(fieldName string, description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				pspProfileRuleFieldName: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(pspProfileIDRangeStrategyRules[fieldName], false),
+					Description:  "The rule of the strategy, MustRunAs requires at least one range",
+				},
+				pspProfileRangeFieldName: {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        getPspProfileRangeSchema(0, 2147483647),
+					Description: "The id ranges of the strategy",
+				},
+			},
+		},
+	}
+}
+
+func resourcePodSecurityPolicyProfileCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Print("[DEBUG] creating pod security policy profile")
+
+	err := validatePodSecurityPolicyProfileConfig(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	httpClientWrapper := m.(client.HttpClientWrapper)
+
+	serviceApi := utils2.GetServiceApi(&httpClientWrapper)
+
+	profile := &model2.PodSecurityPolicyProfile{}
+	err = serviceApi.PodSecurityPolicyProfiles().Create(ctx, httpClientWrapper.HttpClient, getPodSecurityPolicyProfileFromConfig(d), profile)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(string(profile.ID))
+
+	return resourcePodSecurityPolicyProfileRead(ctx, d, m)
+}
+
+func resourcePodSecurityPolicyProfileRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Print("[DEBUG] reading pod security policy profile")
+
+	httpClientWrapper := m.(client.HttpClientWrapper)
+
+	serviceApi := utils2.GetServiceApi(&httpClientWrapper)
+
+	profile := &model2.PodSecurityPolicyProfile{}
+	err := serviceApi.PodSecurityPolicyProfiles().Get(ctx, httpClientWrapper.HttpClient, strfmt.UUID(d.Id()), profile)
+	if escherClient.IsNotFound(err) {
+		// Tell terraform the pod security policy profile doesn't exist
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(updatePodSecurityPolicyProfileMutableFields(d, profile))
+}
+
+func resourcePodSecurityPolicyProfileUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Print("[DEBUG] updating pod security policy profile")
+
+	err := validatePodSecurityPolicyProfileConfig(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	httpClientWrapper := m.(client.HttpClientWrapper)
+
+	serviceApi := utils2.GetServiceApi(&httpClientWrapper)
+
+	err = serviceApi.PodSecurityPolicyProfiles().Update(ctx, httpClientWrapper.HttpClient, strfmt.UUID(d.Id()), getPodSecurityPolicyProfileFromConfig(d), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourcePodSecurityPolicyProfileRead(ctx, d, m)
+}
+
+func resourcePodSecurityPolicyProfileDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Print("[DEBUG] deleting pod security policy profile")
+
+	httpClientWrapper := m.(client.HttpClientWrapper)
+
+	serviceApi := utils2.GetServiceApi(&httpClientWrapper)
+
+	err := serviceApi.PodSecurityPolicyProfiles().Delete(ctx, httpClientWrapper.HttpClient, strfmt.UUID(d.Id()))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Tell terraform the pod security policy profile doesn't exist
+	d.SetId("")
+
+	return nil
+}
+
+func validatePodSecurityPolicyProfileConfig(d *schema.ResourceData) error {
+	log.Printf("[DEBUG] validating pod security policy profile config")
+
+	for _, hostPort := range d.Get(pspProfileHostPortFieldName).(*schema.Set).List() {
+		if err := validatePspProfileRange(pspProfileHostPortFieldName, hostPort.(map[string]interface{})); err != nil {
+			return err
+		}
+	}
+
+	for fieldName := range pspProfileIDRangeStrategyRules {
+		strategies := d.Get(fieldName).([]interface{})
+		if len(strategies) == 0 {
+			continue
+		}
+
+		strategy := strategies[0].(map[string]interface{})
+		ranges := strategy[pspProfileRangeFieldName].(*schema.Set).List()
+		if strategy[pspProfileRuleFieldName] == model2.PodSecurityPolicyIDRangeStrategyRuleMustRunAs && len(ranges) == 0 {
+			return fmt.Errorf("%s with rule %s must have at least one range", fieldName, model2.PodSecurityPolicyIDRangeStrategyRuleMustRunAs)
+		}
+
+		for _, idRange := range ranges {
+			if err := validatePspProfileRange(fieldName, idRange.(map[string]interface{})); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func validatePspProfileRange(fieldName string, idRange map[string]interface{}) error {
+	if idRange[pspProfileMinFieldName].(int) > idRange[pspProfileMaxFieldName].(int) {
+		return fmt.Errorf("a range of %s has min %d greater than max %d", fieldName, idRange[pspProfileMinFieldName], idRange[pspProfileMaxFieldName])
+	}
+
+	return nil
+}
+
+func getPodSecurityPolicyProfileFromConfig(d *schema.ResourceData) *model2.PodSecurityPolicyProfile {
+	log.Print("[DEBUG] getting pod security policy profile from config")
+
+	name := d.Get(nameFieldName).(string)
+
+	return &model2.PodSecurityPolicyProfile{
+		Name:                     &name,
+		Description:              d.Get(descriptionFieldName).(string),
+		Privileged:               d.Get(pspProfilePrivilegedFieldName).(bool),
+		AllowPrivilegeEscalation: d.Get(pspProfileAllowPrivilegeEscalationFieldName).(bool),
+		HostNetwork:              d.Get(pspProfileHostNetworkFieldName).(bool),
+		HostPID:                  d.Get(pspProfileHostPIDFieldName).(bool),
+		HostIPC:                  d.Get(pspProfileHostIPCFieldName).(bool),
+		ReadOnlyRootFilesystem:   d.Get(pspProfileReadOnlyRootFilesystemFieldName).(bool),
+		AllowedCapabilities:      getStringsFromSet(d.Get(pspProfileAllowedCapabilitiesFieldName).(*schema.Set)),
+		DefaultAddCapabilities:   getStringsFromSet(d.Get(pspProfileDefaultAddCapabilitiesFieldName).(*schema.Set)),
+		RequiredDropCapabilities: getStringsFromSet(d.Get(pspProfileRequiredDropCapabilitiesFieldName).(*schema.Set)),
+		Volumes:                  getStringsFromSet(d.Get(pspProfileVolumesFieldName).(*schema.Set)),
+		HostPorts:                getPspProfileRangesFromConfig(d.Get(pspProfileHostPortFieldName).(*schema.Set)),
+		RunAsUser:                getPspProfileIDRangeStrategyFromConfig(d, pspProfileRunAsUserFieldName),
+		RunAsGroup:               getPspProfileIDRangeStrategyFromConfig(d, pspProfileRunAsGroupFieldName),
+		SupplementalGroups:       getPspProfileIDRangeStrategyFromConfig(d, pspProfileSupplementalGroupsFieldName),
+		FsGroup:                  getPspProfileIDRangeStrategyFromConfig(d, pspProfileFsGroupFieldName),
+	}
+}
+
+func getStringsFromSet(set *schema.Set) []string {
+	values := make([]string, 0, set.Len())
+	for _, value := range set.List() {
+		values = append(values, value.(string))
+	}
+
+	return values
+}
+
+func getPspProfileRangesFromConfig(set *schema.Set) []*model2.PodSecurityPolicyRange {
+	ranges := make([]*model2.PodSecurityPolicyRange, 0, set.Len())
+	for _, idRange := range set.List() {
+		idRangeMap := idRange.(map[string]interface{})
+		ranges = append(ranges, &model2.PodSecurityPolicyRange{
+			Min: int64(idRangeMap[pspProfileMinFieldName].(int)),
+			Max: int64(idRangeMap[pspProfileMaxFieldName].(int)),
+		})
+	}
+
+	return ranges
+}
+
+func getPspProfileIDRangeStrategyFromConfig(d *schema.ResourceData, fieldName string) *model2.PodSecurityPolicyIDRangeStrategy {
+	strategies := d.Get(fieldName).([]interface{})
+	if len(strategies) == 0 {
+		return nil
+	}
+
+	strategy := strategies[0].(map[string]interface{})
+
+	return &model2.PodSecurityPolicyIDRangeStrategy{
+		Rule:   strategy[pspProfileRuleFieldName].(string),
+		Ranges: getPspProfileRangesFromConfig(strategy[pspProfileRangeFieldName].(*schema.Set)),
+	}
+}
+
+func updatePodSecurityPolicyProfileMutableFields(d *schema.ResourceData, profile *model2.PodSecurityPolicyProfile) error {
+	log.Print("[DEBUG] updating pod security policy profile mutable fields")
+
+	_ = d.Set(nameFieldName, profile.Name)
+	_ = d.Set(descriptionFieldName, profile.Description)
+	_ = d.Set(pspProfilePrivilegedFieldName, profile.Privileged)
+	_ = d.Set(pspProfileAllowPrivilegeEscalationFieldName, profile.AllowPrivilegeEscalation)
+	_ = d.Set(pspProfileHostNetworkFieldName, profile.HostNetwork)
+	_ = d.Set(pspProfileHostPIDFieldName, profile.HostPID)
+	_ = d.Set(pspProfileHostIPCFieldName, profile.HostIPC)
+	_ = d.Set(pspProfileReadOnlyRootFilesystemFieldName, profile.ReadOnlyRootFilesystem)
+
+	for fieldName, value := range map[string]interface{}{
+		pspProfileAllowedCapabilitiesFieldName:      profile.AllowedCapabilities,
+		pspProfileDefaultAddCapabilitiesFieldName:   profile.DefaultAddCapabilities,
+		pspProfileRequiredDropCapabilitiesFieldName: profile.RequiredDropCapabilities,
+		pspProfileVolumesFieldName:                  profile.Volumes,
+		pspProfileHostPortFieldName:                 getPspProfileRangesInTf(profile.HostPorts),
+		pspProfileRunAsUserFieldName:                getPspProfileIDRangeStrategyInTf(profile.RunAsUser),
+		pspProfileRunAsGroupFieldName:               getPspProfileIDRangeStrategyInTf(profile.RunAsGroup),
+		pspProfileSupplementalGroupsFieldName:       getPspProfileIDRangeStrategyInTf(profile.SupplementalGroups),
+		pspProfileFsGroupFieldName:                  getPspProfileIDRangeStrategyInTf(profile.FsGroup),
+	} {
+		err := d.Set(fieldName, value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func getPspProfileRangesInTf(ranges []*model2.PodSecurityPolicyRange) []interface{} {
+	rangesInTf := make([]interface{}, 0, len(ranges))
+	for _, idRange := range ranges {
+		rangesInTf = append(rangesInTf, map[string]interface{}{
+			pspProfileMinFieldName: int(idRange.Min),
+			pspProfileMaxFieldName: int(idRange.Max),
+		})
+	}
+
+	return rangesInTf
+}
+
+func getPspProfileIDRangeStrategyInTf(strategy *model2.PodSecurityPolicyIDRangeStrategy) []interface{} {
+	if strategy == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			pspProfileRuleFieldName:  strategy.Rule,
+			pspProfileRangeFieldName: getPspProfileRangesInTf(strategy.Ranges),
+		},
+	}
+}
@@ -0,0 +1,107 @@
+package securecn
+
+import (
+	"context"
+	"testing"
+
+	model2 "terraform-provider-securecn/internal/escher_api/model"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func getTestPspProfileConfig() map[string]interface{} {
+	return map[string]interface{}{
+		nameFieldName:                               "restricted",
+		pspProfileHostNetworkFieldName:              true,
+		pspProfileRequiredDropCapabilitiesFieldName: []interface{}{"NET_RAW", "ALL"},
+		pspProfileVolumesFieldName:                  []interface{}{"secret", "configMap", "emptyDir"},
+		pspProfileHostPortFieldName: []interface{}{
+			map[string]interface{}{pspProfileMinFieldName: 8080, pspProfileMaxFieldName: 8090},
+		},
+		pspProfileRunAsUserFieldName: []interface{}{
+			map[string]interface{}{
+				pspProfileRuleFieldName: "MustRunAs",
+				pspProfileRangeFieldName: []interface{}{
+					map[string]interface{}{pspProfileMinFieldName: 1000, pspProfileMaxFieldName: 2000},
+				},
+			},
+		},
+		pspProfileFsGroupFieldName: []interface{}{
+			map[string]interface{}{pspProfileRuleFieldName: "RunAsAny"},
+		},
+	}
+}
+
+func TestPodSecurityPolicyProfileUpdateReadsBackWithoutDiff(t *testing.T) {
+	name := "restricted"
+	api := newFakeSecureCNApi(t)
+	profileId := api.addModel(fakeApiPspProfiles, &model2.PodSecurityPolicyProfile{Name: &name})
+
+	d := schema.TestResourceDataRaw(t, ResourcePodSecurityPolicyProfile().Schema, getTestPspProfileConfig())
+	d.SetId(profileId)
+	configured := ResourcePodSecurityPolicyProfile().Data(d.State())
+
+	diags := resourcePodSecurityPolicyProfileUpdate(context.Background(), d, api.client())
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+
+	profile := &model2.PodSecurityPolicyProfile{}
+	api.decodeObject(fakeApiPspProfiles, profileId, profile)
+
+	if profile.RunAsUser == nil || len(profile.RunAsUser.Ranges) != 1 || profile.RunAsUser.Ranges[0].Min != 1000 {
+		t.Fatalf("unexpected run as user sent: %+v", profile.RunAsUser)
+	}
+	if !profile.HostNetwork || len(profile.Volumes) != 3 || profile.RunAsGroup != nil {
+		t.Fatalf("unexpected profile sent: %+v", profile)
+	}
+
+	for _, fieldName := range []string{pspProfileRequiredDropCapabilitiesFieldName, pspProfileVolumesFieldName, pspProfileHostPortFieldName} {
+		if !d.Get(fieldName).(*schema.Set).Equal(configured.Get(fieldName)) {
+			t.Fatalf("expected no diff in %s, configured: %v, read: %v", fieldName, configured.Get(fieldName), d.Get(fieldName))
+		}
+	}
+	if d.Get(pspProfileRunAsUserFieldName+".0."+pspProfileRuleFieldName) != "MustRunAs" || len(d.Get(pspProfileRunAsGroupFieldName).([]interface{})) != 0 {
+		t.Fatalf("unexpected id range strategies read: %v, %v", d.Get(pspProfileRunAsUserFieldName), d.Get(pspProfileRunAsGroupFieldName))
+	}
+}
+
+func TestPodSecurityPolicyProfileRejectsMustRunAsWithoutRanges(t *testing.T) {
+	config := getTestPspProfileConfig()
+	config[pspProfileRunAsGroupFieldName] = []interface{}{
+		map[string]interface{}{pspProfileRuleFieldName: "MustRunAs"},
+	}
+
+	d := schema.TestResourceDataRaw(t, ResourcePodSecurityPolicyProfile().Schema, config)
+
+	if err := validatePodSecurityPolicyProfileConfig(d); err == nil {
+		t.Fatal("expected MustRunAs without ranges to be rejected")
+	}
+}
+
+func TestPodSecurityPolicyProfileLifecycle(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	lifecycle := newTestResourceLifecycle(ResourcePodSecurityPolicyProfile(), api.client())
+
+	state := lifecycle.apply(t, getTestPspProfileConfig())
+	profile := api.object(fakeApiPspProfiles, state.ID)
+	if profile["name"] != "restricted" || profile["hostNetwork"] != true {
+		t.Fatalf("unexpected pod security policy profile created: %v", profile)
+	}
+
+	config := getTestPspProfileConfig()
+	config[pspProfileHostNetworkFieldName] = false
+	config[pspProfileVolumesFieldName] = []interface{}{"secret"}
+	updatedState := lifecycle.apply(t, config)
+	profile = api.object(fakeApiPspProfiles, state.ID)
+	if updatedState.ID != state.ID || profile["hostNetwork"] == true || len(profile["volumes"].([]interface{})) != 1 {
+		t.Fatalf("expected the pod security policy profile to be updated in place, got %s: %v", updatedState.ID, profile)
+	}
+
+	lifecycle.importState(t)
+
+	lifecycle.destroy(t)
+	if ids := api.objectIds(fakeApiPspProfiles); len(ids) != 0 {
+		t.Fatalf("expected the pod security policy profile to be deleted, got %v", ids)
+	}
+}
@@ -3,9 +3,13 @@ package securecn
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
+	"regexp"
+	"sort"
 	"strings"
 	"terraform-provider-securecn/internal/client"
+	"terraform-provider-securecn/internal/escher_api/escherClient"
 	model2 "terraform-provider-securecn/internal/escher_api/model"
 	utils2 "terraform-provider-securecn/internal/utils"
 
@@ -20,6 +24,7 @@ const serverlessRuleGroupName = "Terraform automated rules"
 const serverlessRuleNameFieldName = "rule_name"
 const serverlessRuleActionFieldName = "action"
 const serverlessRuleStatusFieldName = "status"
+const serverlessRuleOriginFieldName = "rule_origin"
 const serverlessRuleScopeFieldName = "scope"
 const serverlessFunctionValidationFieldName = "serverless_function_validation"
 
@@ -39,147 +44,184 @@ const validationFieldIsUnusedFunction = "is_unused_function"
 const scopeFieldCloudAccount = "cloud_account"
 const scopeFieldRegions = "regions"
 
+const awsRegionPattern = `[a-z]{2}(-gov|-iso[a-z]?)?-[a-z]+-[0-9]`
+
+var awsRegionRegex = regexp.MustCompile(`^` + awsRegionPattern + `$`)
+var awsAccountIdRegex = regexp.MustCompile(`^[0-9]{12}$`)
+var lambdaFunctionNameRegex = regexp.MustCompile(`^[a-zA-Z0-9-_]{1,64}$`)
+var lambdaFunctionQualifierRegex = regexp.MustCompile(`^(\$LATEST|[a-zA-Z0-9-_]{1,128})$`)
+
 func ResourceServerlessRule() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceServerlessRuleCreate,
 		ReadContext:   resourceServerlessRuleRead,
 		UpdateContext: resourceServerlessRuleUpdate,
 		DeleteContext: resourceServerlessRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Description:   "A SecureCN serverless rule",
-		SchemaVersion: 1,
-		Schema: map[string]*schema.Schema{
-			serverlessRuleNameFieldName: {
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			serverlessRuleStatusFieldName: {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "ENABLED",
-				ValidateFunc: validation.StringInSlice([]string{"ENABLED"}, false),
-			},
-			serverlessRuleActionFieldName: {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "ALLOW",
-				ValidateFunc: validation.StringInSlice([]string{"ALLOW"}, false),
+		SchemaVersion: 2,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 1,
+				Type:    resourceServerlessRuleV1().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceServerlessRuleStateUpgradeV1,
 			},
-			serverlessRuleScopeFieldName: {
-				Description: "Scope defines the scope of this rule",
-				Optional:    true,
-				Type:        schema.TypeList,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						scopeFieldCloudAccount: {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						scopeFieldRegions: {
-							Type:     schema.TypeList,
-							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		Schema: getServerlessRuleSchema(),
+	}
+}
+
+func getServerlessRuleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		serverlessRuleNameFieldName: {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		serverlessRuleStatusFieldName: {
+			Description:  "The status of the rule, ENABLED or DISABLED",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      string(model2.ServerlessRuleStatusENABLED),
+			ValidateFunc: validation.StringInSlice([]string{string(model2.ServerlessRuleStatusENABLED), string(model2.ServerlessRuleStatusDISABLED)}, false),
+		},
+		serverlessRuleActionFieldName: {
+			Description:  "The action of the rule, ALLOW, DETECT or BLOCK",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      string(model2.ServerlessRuleActionALLOW),
+			ValidateFunc: validation.StringInSlice([]string{string(model2.ServerlessRuleActionALLOW), string(model2.ServerlessRuleActionDETECT), string(model2.ServerlessRuleActionBLOCK)}, false),
+		},
+		serverlessRuleOriginFieldName: {
+			Description: "The origin of the rule, USER, AUTOMATED_POLICY or SYSTEM",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		serverlessRuleScopeFieldName: {
+			Description: "Scope defines the scope of this rule",
+			Optional:    true,
+			Type:        schema.TypeList,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					scopeFieldCloudAccount: {
+						Description:  "The name of the cloud account in SecureCN",
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					scopeFieldRegions: {
+						Description: "The AWS regions of the cloud account, e.g. us-east-1, empty for any region",
+						Type:        schema.TypeList,
+						Optional:    true,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validation.StringMatch(awsRegionRegex, "must be an AWS region name, e.g. us-east-1"),
 						},
 					},
 				},
 			},
-			matchByFunctionNameFieldName: {
-				Description:  "The rule will match using function names",
-				Type:         schema.TypeList,
-				MaxItems:     1,
-				MinItems:     1,
-				Optional:     true,
-				ExactlyOneOf: []string{matchByFunctionArnFieldName, matchByFunctionAnyFieldName},
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						serverlessRuleNamesFieldName: {
-							Type:     schema.TypeList,
-							MinItems: 1,
-							Required: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
+		},
+		matchByFunctionNameFieldName: {
+			Description:  "The rule will match using function names",
+			Type:         schema.TypeList,
+			MaxItems:     1,
+			MinItems:     1,
+			Optional:     true,
+			ExactlyOneOf: []string{matchByFunctionArnFieldName, matchByFunctionAnyFieldName},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					serverlessRuleNamesFieldName: {
+						Type:     schema.TypeList,
+						MinItems: 1,
+						Required: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
 					},
 				},
 			},
-			matchByFunctionArnFieldName: {
-				Description:  "The rule will match using function arns",
-				Type:         schema.TypeList,
-				MaxItems:     1,
-				MinItems:     1,
-				Optional:     true,
-				ExactlyOneOf: []string{matchByFunctionNameFieldName, matchByFunctionAnyFieldName},
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						serverlessRuleArnsFieldName: {
-							Required: true,
-							Type:     schema.TypeMap,
-							Elem: &schema.Schema{
-								Type: schema.TypeString,
-							},
+		},
+		matchByFunctionArnFieldName: {
+			Description:  "The rule will match using function arns",
+			Type:         schema.TypeList,
+			MaxItems:     1,
+			MinItems:     1,
+			Optional:     true,
+			ExactlyOneOf: []string{matchByFunctionNameFieldName, matchByFunctionAnyFieldName},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					serverlessRuleArnsFieldName: {
+						Description: "The ARNs of the lambda functions, e.g. arn:aws:lambda:us-east-1:123456789012:function:my-function, optionally qualified with a version or an alias",
+						Required:    true,
+						MinItems:    1,
+						Type:        schema.TypeList,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validateLambdaFunctionArn,
 						},
 					},
 				},
 			},
-			matchByFunctionAnyFieldName: {
-				Description:  "The rule will match on any function",
-				Type:         schema.TypeBool,
-				Optional:     true,
-				ExactlyOneOf: []string{matchByFunctionNameFieldName, matchByFunctionArnFieldName},
-			},
-			serverlessFunctionValidationFieldName: {
-				Description: "Define function security validations",
-				Type:        schema.TypeList,
-				MaxItems:    1,
-				MinItems:    1,
-				Optional:    true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						validationFieldRisk: {
-							Optional: true,
-							Type:     schema.TypeString,
-							ValidateFunc: validation.StringInSlice([]string{
-								"NO_RISK", "LOW", "MEDIUM", "HIGH", "CRITICAL",
-							}, true),
-						},
-						validationFieldVulnerability: {
-							Optional: true,
-							Type:     schema.TypeString,
-							ValidateFunc: validation.StringInSlice([]string{
-								"UNKNOWN", "LOW", "MEDIUM", "HIGH", "CRITICAL",
-							}, true),
-						},
-						validationFieldSecretsRisk: {
-							Optional: true,
-							Type:     schema.TypeString,
-							ValidateFunc: validation.StringInSlice([]string{
-								"NO_KNOWN_RISK", "RISK_IDENTIFIED",
-							}, true),
-						},
-						validationFieldFunctionPermissionRisk: {
-							Optional: true,
-							Type:     schema.TypeString,
-							ValidateFunc: validation.StringInSlice([]string{
-								"NO_RISK", "LOW", "MEDIUM", "HIGH", "CRITICAL",
-							}, true),
-						},
-						validationFieldPubliclyAccessibleRisk: {
-							Optional: true,
-							Type:     schema.TypeString,
-							ValidateFunc: validation.StringInSlice([]string{
-								"NO_RISK", "LOW", "MEDIUM",
-							}, true),
-						},
-						validationFieldDataAccessRisk: {
-							Optional: true,
-							Type:     schema.TypeString,
-							ValidateFunc: validation.StringInSlice([]string{
-								"NO_RISK", "LOW",
-							}, true),
-						},
-						validationFieldIsUnusedFunction: {
-							Type:     schema.TypeBool,
-							Optional: true,
-							Default:  nil,
-						},
+		},
+		matchByFunctionAnyFieldName: {
+			Description:  "The rule will match on any function",
+			Type:         schema.TypeBool,
+			Optional:     true,
+			ExactlyOneOf: []string{matchByFunctionNameFieldName, matchByFunctionArnFieldName},
+		},
+		serverlessFunctionValidationFieldName: {
+			Description: "Define function security validations",
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			MinItems:    1,
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					validationFieldRisk: {
+						Optional: true,
+						Type:     schema.TypeString,
+						ValidateFunc: validation.StringInSlice([]string{
+							"NO_RISK", "LOW", "MEDIUM", "HIGH", "CRITICAL",
+						}, true),
+					},
+					validationFieldVulnerability: {
+						Optional: true,
+						Type:     schema.TypeString,
+						ValidateFunc: validation.StringInSlice([]string{
+							"UNKNOWN", "LOW", "MEDIUM", "HIGH", "CRITICAL",
+						}, true),
+					},
+					validationFieldSecretsRisk: {
+						Optional: true,
+						Type:     schema.TypeString,
+						ValidateFunc: validation.StringInSlice([]string{
+							"NO_KNOWN_RISK", "RISK_IDENTIFIED",
+						}, true),
+					},
+					validationFieldFunctionPermissionRisk: {
+						Optional: true,
+						Type:     schema.TypeString,
+						ValidateFunc: validation.StringInSlice([]string{
+							"NO_RISK", "LOW", "MEDIUM", "HIGH", "CRITICAL",
+						}, true),
+					},
+					validationFieldPubliclyAccessibleRisk: {
+						Optional: true,
+						Type:     schema.TypeString,
+						ValidateFunc: validation.StringInSlice([]string{
+							"NO_RISK", "LOW", "MEDIUM",
+						}, true),
+					},
+					validationFieldDataAccessRisk: {
+						Optional: true,
+						Type:     schema.TypeString,
+						ValidateFunc: validation.StringInSlice([]string{
+							"NO_RISK", "LOW",
+						}, true),
+					},
+					validationFieldIsUnusedFunction: {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  nil,
 					},
 				},
 			},
@@ -199,16 +241,17 @@ func resourceServerlessRuleCreate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	rule, err := serviceApi.CreateServerlessRule(ctx, httpClientWrapper.HttpClient, serverlessRuleFromConfig)
+	rule := &model2.CdServerlessRule{}
+	err = serviceApi.ServerlessRules().Create(ctx, httpClientWrapper.HttpClient, serverlessRuleFromConfig, rule)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	ruleId := rule.Payload.ID
+	ruleId := rule.ID
 
 	d.SetId(string(ruleId))
 
-	return nil
+	return resourceServerlessRuleRead(ctx, d, m)
 }
 
 func resourceServerlessRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -218,16 +261,17 @@ func resourceServerlessRuleRead(ctx context.Context, d *schema.ResourceData, m i
 
 	serviceApi := utils2.GetServiceApi(&httpClientWrapper)
 	ruleId := d.Id()
-	currentRuleInSecureCN, err := serviceApi.GetServerlessRule(ctx, httpClientWrapper.HttpClient, strfmt.UUID(ruleId))
-	if err != nil {
+	currentRuleInSecureCN := &model2.CdServerlessRule{}
+	err := serviceApi.ServerlessRules().Get(ctx, httpClientWrapper.HttpClient, strfmt.UUID(ruleId), currentRuleInSecureCN)
+	if err != nil && !escherClient.IsNotFound(err) {
 		return diag.FromErr(err)
 	}
 
-	if currentRuleInSecureCN.Payload.ID == "" || *currentRuleInSecureCN.Payload.Status == "DELETED" {
+	if escherClient.IsNotFound(err) || currentRuleInSecureCN.ID == "" || (currentRuleInSecureCN.Status != nil && *currentRuleInSecureCN.Status == "DELETED") {
 		// Tell terraform the rule doesn't exist
 		d.SetId("")
 	} else {
-		err = updateServerlessRuleMutableFields(d, currentRuleInSecureCN.Payload)
+		err = updateServerlessRuleMutableFields(d, currentRuleInSecureCN)
 		return diag.FromErr(err)
 	}
 
@@ -248,12 +292,13 @@ func resourceServerlessRuleUpdate(ctx context.Context, d *schema.ResourceData, m
 
 	rule.ID = strfmt.UUID(d.Id())
 
-	updatedRule, err := serviceApi.UpdateServerlessRule(ctx, httpClientWrapper.HttpClient, rule, rule.ID)
+	updatedRule := &model2.CdServerlessRule{}
+	err = serviceApi.ServerlessRules().Update(ctx, httpClientWrapper.HttpClient, rule.ID, rule, updatedRule)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.SetId(string(updatedRule.Payload.ID))
+	d.SetId(string(updatedRule.ID))
 
 	return resourceServerlessRuleRead(ctx, d, m)
 }
@@ -264,7 +309,7 @@ func resourceServerlessRuleDelete(ctx context.Context, d *schema.ResourceData, m
 	httpClientWrapper := m.(client.HttpClientWrapper)
 
 	serviceApi := utils2.GetServiceApi(&httpClientWrapper)
-	err := serviceApi.DeleteServerlessRule(ctx, httpClientWrapper.HttpClient, strfmt.UUID(d.Id()))
+	err := serviceApi.ServerlessRules().Delete(ctx, httpClientWrapper.HttpClient, strfmt.UUID(d.Id()))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -322,7 +367,7 @@ func getRuleType(d *schema.ResourceData) (model2.ServerlessRuleType, error) {
 
 	matchByFunctionArns := d.Get(matchByFunctionArnFieldName).([]interface{})
 	if len(matchByFunctionArns) != 0 {
-		funcArns := utils2.ReadNestedListStringFromTF(d, matchByFunctionArnFieldName, serverlessRuleNamesFieldName, 0)
+		funcArns := utils2.ReadNestedListStringFromTF(d, matchByFunctionArnFieldName, serverlessRuleArnsFieldName, 0)
 		ruleType := &model2.FunctionArnServerlessRuleType{
 			Arns: funcArns,
 		}
@@ -346,7 +391,7 @@ func getRuleScope(d *schema.ResourceData) ([]*model2.ServerlessRuleScope, error)
 	if len(scope) == 0 {
 		return nil, nil
 	}
-	scopes := make([]*model2.ServerlessRuleScope, len(scope))
+	scopes := make([]*model2.ServerlessRuleScope, 0, len(scope))
 	if len(scope) != 0 {
 		for i := 0; i < len(scope); i++ {
 			cloudAccount := utils2.ReadNestedStringFromTF(d, serverlessRuleScopeFieldName, scopeFieldCloudAccount, i)
@@ -463,24 +508,21 @@ func getServerlessFunctionRiskFromString(serverlessFunctionRiskLevel string) mod
 }
 
 func getServerlessStatusFromString(status string) model2.ServerlessRuleStatus {
-	/*
-		for now we support only ENABLED
-	*/
+	if status == string(model2.ServerlessRuleStatusDISABLED) {
+		return model2.ServerlessRuleStatusDISABLED
+	}
 
-	//if status == "ENABLED" {
 	return model2.ServerlessRuleStatusENABLED
-	//}
-
 }
 
 func getServerlessRuleActionFromString(actionString string) model2.ServerlessRuleAction {
-	/*
-		for now we support only ALLOW
-	*/
+	if actionString == string(model2.ServerlessRuleActionDETECT) {
+		return model2.ServerlessRuleActionDETECT
+	} else if actionString == string(model2.ServerlessRuleActionBLOCK) {
+		return model2.ServerlessRuleActionBLOCK
+	}
 
-	//if action == "ALLOW" {
 	return model2.ServerlessRuleActionALLOW
-	//}
 }
 
 func updateServerlessRuleMutableFields(d *schema.ResourceData, currentRuleInSecureCN *model2.CdServerlessRule) error {
@@ -490,11 +532,27 @@ func updateServerlessRuleMutableFields(d *schema.ResourceData, currentRuleInSecu
 	if err != nil {
 		return err
 	}
-	err = d.Set(serverlessRuleActionFieldName, currentRuleInSecureCN.Action)
+	action := ""
+	if currentRuleInSecureCN.Action != nil {
+		action = string(*currentRuleInSecureCN.Action)
+	}
+	err = d.Set(serverlessRuleActionFieldName, action)
+	if err != nil {
+		return err
+	}
+	status := ""
+	if currentRuleInSecureCN.Status != nil {
+		status = string(*currentRuleInSecureCN.Status)
+	}
+	err = d.Set(serverlessRuleStatusFieldName, status)
 	if err != nil {
 		return err
 	}
-	err = d.Set(serverlessRuleStatusFieldName, currentRuleInSecureCN.Status)
+	ruleOrigin := ""
+	if currentRuleInSecureCN.RuleOrigin != nil {
+		ruleOrigin = string(*currentRuleInSecureCN.RuleOrigin)
+	}
+	err = d.Set(serverlessRuleOriginFieldName, ruleOrigin)
 	if err != nil {
 		return err
 	}
@@ -543,6 +601,12 @@ func updateServerlessRuleMutableFields(d *schema.ResourceData, currentRuleInSecu
 }
 
 func updateServerlessRuleMutableFieldsValidation(d *schema.ResourceData, currentRuleInSecureCN *model2.CdServerlessRule, err error) error {
+	// a rule without validations is read back without the validation block
+	validationInSecureCN := currentRuleInSecureCN.Rule().ServerlessFunctionValidation()
+	if validationInSecureCN == nil || *validationInSecureCN == (model2.ServerlessFunctionValidation{}) {
+		return d.Set(serverlessFunctionValidationFieldName, nil)
+	}
+
 	funcValidations := make([]map[string]interface{}, 0, 1)
 	funcValidation := make(map[string]interface{})
 	funcValidation[validationFieldRisk] = currentRuleInSecureCN.Rule().ServerlessFunctionValidation().Risk
@@ -568,7 +632,7 @@ func updateServerlessRuleMutableFieldsScope(d *schema.ResourceData, currentRuleI
 		cloudAccountInSecureCN := *singleScopeInSecureCN.CloudAccount
 		regionsInSecureCn := singleScopeInSecureCN.Regions
 		regionsInTf := make([]string, 0, len(regionsInSecureCn))
-		for _, singleRegionInSingleScopeSecureCN := range regionsInTf {
+		for _, singleRegionInSingleScopeSecureCN := range regionsInSecureCn {
 			regionsInTf = append(regionsInTf, singleRegionInSingleScopeSecureCN)
 		}
 		singleScopeInTf[scopeFieldCloudAccount] = cloudAccountInSecureCN
@@ -582,3 +646,79 @@ func updateServerlessRuleMutableFieldsScope(d *schema.ResourceData, currentRuleI
 	}
 	return nil
 }
+
+// validateLambdaFunctionArn validates a lambda function ARN in the form of
+// arn:<partition>:lambda:<region>:<account id>:function:<name>[:<version or alias>]
+func validateLambdaFunctionArn(i interface{}, k string) ([]string, []error) {
+	arn, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	parts := strings.Split(arn, ":")
+	if len(parts) < 7 || len(parts) > 8 || parts[0] != "arn" || parts[2] != "lambda" || parts[5] != "function" {
+		return nil, []error{fmt.Errorf("expected %s to be a lambda function ARN in the form of arn:aws:lambda:<region>:<account id>:function:<name>, got %s", k, arn)}
+	}
+
+	var errs []error
+	if !utils2.ContainsString([]string{"aws", "aws-cn", "aws-us-gov"}, parts[1]) {
+		errs = append(errs, fmt.Errorf("expected the partition of %s to be one of [aws aws-cn aws-us-gov], got %s", k, parts[1]))
+	}
+	if !awsRegionRegex.MatchString(parts[3]) {
+		errs = append(errs, fmt.Errorf("expected the region of %s to be an AWS region name, got %s", k, parts[3]))
+	}
+	if !awsAccountIdRegex.MatchString(parts[4]) {
+		errs = append(errs, fmt.Errorf("expected the account id of %s to be 12 digits, got %s", k, parts[4]))
+	}
+	if !lambdaFunctionNameRegex.MatchString(parts[6]) {
+		errs = append(errs, fmt.Errorf("expected the function name of %s to be 1 to 64 letters, digits, hyphens or underscores, got %s", k, parts[6]))
+	}
+	if len(parts) == 8 && !lambdaFunctionQualifierRegex.MatchString(parts[7]) {
+		errs = append(errs, fmt.Errorf("expected the qualifier of %s to be $LATEST, a version or an alias, got %s", k, parts[7]))
+	}
+
+	return nil, errs
+}
+
+// resourceServerlessRuleV1 is the schema of version 1, where match_by_function_arn.arns was a map
+func resourceServerlessRuleV1() *schema.Resource {
+	schemaV1 := getServerlessRuleSchema()
+	matchByFunctionArnV1 := schemaV1[matchByFunctionArnFieldName].Elem.(*schema.Resource)
+	matchByFunctionArnV1.Schema[serverlessRuleArnsFieldName] = &schema.Schema{
+		Required: true,
+		Type:     schema.TypeMap,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+
+	return &schema.Resource{Schema: schemaV1}
+}
+
+// resourceServerlessRuleStateUpgradeV1 turns the arns map of version 1 into a list of its values, ordered by key
+func resourceServerlessRuleStateUpgradeV1(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	matchByFunctionArn, ok := rawState[matchByFunctionArnFieldName].([]interface{})
+	if !ok {
+		return rawState, nil
+	}
+
+	for _, matchByFunctionArnEntry := range matchByFunctionArn {
+		matchByFunctionArnMap, ok := matchByFunctionArnEntry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		arnsMap, _ := matchByFunctionArnMap[serverlessRuleArnsFieldName].(map[string]interface{})
+		keys := make([]string, 0, len(arnsMap))
+		for key := range arnsMap {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		arns := make([]interface{}, 0, len(keys))
+		for _, key := range keys {
+			arns = append(arns, arnsMap[key])
+		}
+		matchByFunctionArnMap[serverlessRuleArnsFieldName] = arns
+	}
+
+	return rawState, nil
+}
@@ -0,0 +1,234 @@
+package securecn
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	model2 "terraform-provider-securecn/internal/escher_api/model"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func getTestServerlessRuleResourceData(t *testing.T, config map[string]interface{}) *schema.ResourceData {
+	rawConfig := map[string]interface{}{
+		serverlessRuleNameFieldName: "block risky functions",
+		matchByFunctionNameFieldName: []interface{}{
+			map[string]interface{}{serverlessRuleNamesFieldName: []interface{}{"payments"}},
+		},
+	}
+	for key, value := range config {
+		rawConfig[key] = value
+	}
+
+	return schema.TestResourceDataRaw(t, ResourceServerlessRule().Schema, rawConfig)
+}
+
+func TestServerlessRuleFromConfigActionAndStatus(t *testing.T) {
+	d := getTestServerlessRuleResourceData(t, map[string]interface{}{
+		serverlessRuleActionFieldName: "BLOCK",
+		serverlessRuleStatusFieldName: "DISABLED",
+	})
+
+	rule, err := getServerlessRuleFromConfig(d)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if *rule.Action != model2.ServerlessRuleActionBLOCK {
+		t.Fatalf("expected action BLOCK, got %s", *rule.Action)
+	}
+	if *rule.Status != model2.ServerlessRuleStatusDISABLED {
+		t.Fatalf("expected status DISABLED, got %s", *rule.Status)
+	}
+}
+
+func TestServerlessRuleDefaultActionAndStatus(t *testing.T) {
+	d := getTestServerlessRuleResourceData(t, nil)
+
+	rule, err := getServerlessRuleFromConfig(d)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if *rule.Action != model2.ServerlessRuleActionALLOW {
+		t.Fatalf("expected action ALLOW, got %s", *rule.Action)
+	}
+	if *rule.Status != model2.ServerlessRuleStatusENABLED {
+		t.Fatalf("expected status ENABLED, got %s", *rule.Status)
+	}
+}
+
+func TestServerlessRuleReadBack(t *testing.T) {
+	d := getTestServerlessRuleResourceData(t, nil)
+
+	name := "detect risky functions"
+	action := model2.ServerlessRuleActionDETECT
+	status := model2.ServerlessRuleStatusDISABLED
+	ruleOrigin := model2.ServerlessRuleOriginAUTOMATEDPOLICY
+	ruleType := &model2.FunctionNameServerlessRuleType{Names: []string{"payments"}}
+	ruleType.SetServerlessFunctionValidation(&model2.ServerlessFunctionValidation{Risk: model2.ServerlessFunctionRiskLevelHIGH})
+	rule := &model2.CdServerlessRule{
+		Action:     &action,
+		Name:       &name,
+		RuleOrigin: &ruleOrigin,
+		Status:     &status,
+	}
+	rule.SetRule(ruleType)
+
+	err := updateServerlessRuleMutableFields(d, rule)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if d.Get(serverlessRuleActionFieldName) != "DETECT" {
+		t.Fatalf("expected action DETECT, got %s", d.Get(serverlessRuleActionFieldName))
+	}
+	if d.Get(serverlessRuleStatusFieldName) != "DISABLED" {
+		t.Fatalf("expected status DISABLED, got %s", d.Get(serverlessRuleStatusFieldName))
+	}
+	if d.Get(serverlessRuleOriginFieldName) != "AUTOMATED_POLICY" {
+		t.Fatalf("expected rule origin AUTOMATED_POLICY, got %s", d.Get(serverlessRuleOriginFieldName))
+	}
+}
+
+func TestServerlessRuleReadBackWithoutActionAndStatus(t *testing.T) {
+	d := getTestServerlessRuleResourceData(t, nil)
+
+	name := "block risky functions"
+	rule := &model2.CdServerlessRule{Name: &name}
+	rule.SetRule(&model2.FunctionNameServerlessRuleType{Names: []string{"payments"}})
+
+	err := updateServerlessRuleMutableFields(d, rule)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if d.Get(serverlessRuleActionFieldName) != "" || d.Get(serverlessRuleStatusFieldName) != "" {
+		t.Fatalf("expected no action and status, got %s and %s", d.Get(serverlessRuleActionFieldName), d.Get(serverlessRuleStatusFieldName))
+	}
+}
+
+func TestServerlessRuleFromConfigArnsAndScope(t *testing.T) {
+	d := getTestServerlessRuleResourceData(t, map[string]interface{}{
+		matchByFunctionNameFieldName: []interface{}{},
+		matchByFunctionArnFieldName: []interface{}{
+			map[string]interface{}{serverlessRuleArnsFieldName: []interface{}{
+				"arn:aws:lambda:us-east-1:123456789012:function:payments",
+				"arn:aws:lambda:eu-west-1:123456789012:function:orders:$LATEST",
+			}},
+		},
+		serverlessRuleScopeFieldName: []interface{}{
+			map[string]interface{}{scopeFieldCloudAccount: "production", scopeFieldRegions: []interface{}{"us-east-1", "eu-west-1"}},
+		},
+	})
+
+	rule, err := getServerlessRuleFromConfig(d)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	arns := rule.Rule().(*model2.FunctionArnServerlessRuleType).Arns
+	if len(arns) != 2 || arns[1] != "arn:aws:lambda:eu-west-1:123456789012:function:orders:$LATEST" {
+		t.Fatalf("unexpected arns: %v", arns)
+	}
+	if len(rule.Scope) != 1 || *rule.Scope[0].CloudAccount != "production" || len(rule.Scope[0].Regions) != 2 {
+		t.Fatalf("unexpected scope: %v", rule.Scope)
+	}
+}
+
+func TestValidateLambdaFunctionArn(t *testing.T) {
+	validArns := []string{
+		"arn:aws:lambda:us-east-1:123456789012:function:payments",
+		"arn:aws-us-gov:lambda:us-gov-west-1:123456789012:function:payments_v2:prod",
+		"arn:aws-cn:lambda:cn-north-1:123456789012:function:payments:3",
+	}
+	for _, arn := range validArns {
+		if _, errs := validateLambdaFunctionArn(arn, serverlessRuleArnsFieldName); len(errs) != 0 {
+			t.Fatalf("expected %s to be valid, got: %v", arn, errs)
+		}
+	}
+
+	invalidArns := []string{
+		"payments",
+		"arn:aws:s3:::my-bucket",
+		"arn:aws:lambda:us-east-1:1234:function:payments",
+		"arn:aws:lambda:useast1:123456789012:function:payments",
+		"arn:aws:lambda:us-east-1:123456789012:function:*",
+		"arn:aws:lambda:us-east-1:123456789012:layer:payments",
+	}
+	for _, arn := range invalidArns {
+		if _, errs := validateLambdaFunctionArn(arn, serverlessRuleArnsFieldName); len(errs) == 0 {
+			t.Fatalf("expected %s to be invalid", arn)
+		}
+	}
+}
+
+func TestServerlessRuleStateUpgradeV1(t *testing.T) {
+	rawState := map[string]interface{}{
+		"id":                        "a4b1c2d3-4e5f-4a6b-8c7d-9e0f1a2b3c4d",
+		serverlessRuleNameFieldName: "allow payments",
+		matchByFunctionArnFieldName: []interface{}{
+			map[string]interface{}{serverlessRuleArnsFieldName: map[string]interface{}{
+				"orders":   "arn:aws:lambda:us-east-1:123456789012:function:orders",
+				"payments": "arn:aws:lambda:us-east-1:123456789012:function:payments",
+			}},
+		},
+	}
+
+	upgradedState, err := resourceServerlessRuleStateUpgradeV1(context.Background(), rawState, nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expectedArns := []interface{}{
+		"arn:aws:lambda:us-east-1:123456789012:function:orders",
+		"arn:aws:lambda:us-east-1:123456789012:function:payments",
+	}
+	arns := upgradedState[matchByFunctionArnFieldName].([]interface{})[0].(map[string]interface{})[serverlessRuleArnsFieldName]
+	if !reflect.DeepEqual(arns, expectedArns) {
+		t.Fatalf("expected arns %v, got %v", expectedArns, arns)
+	}
+}
+
+func TestServerlessRuleLifecycle(t *testing.T) {
+	api := newFakeSecureCNApi(t)
+	lifecycle := newTestResourceLifecycle(ResourceServerlessRule(), api.client())
+
+	state := lifecycle.apply(t, map[string]interface{}{
+		serverlessRuleNameFieldName:   "block risky payments",
+		serverlessRuleActionFieldName: "BLOCK",
+		matchByFunctionNameFieldName: []interface{}{
+			map[string]interface{}{serverlessRuleNamesFieldName: []interface{}{"payments"}},
+		},
+		serverlessFunctionValidationFieldName: []interface{}{
+			map[string]interface{}{validationFieldRisk: "HIGH"},
+		},
+	})
+	if rule := api.object(fakeApiServerlessRules, state.ID); rule["action"] != "BLOCK" {
+		t.Fatalf("unexpected serverless rule created: %v", rule)
+	}
+
+	lifecycle.importState(t)
+
+	updatedState := lifecycle.apply(t, map[string]interface{}{
+		serverlessRuleNameFieldName:   "detect production functions",
+		serverlessRuleActionFieldName: "DETECT",
+		matchByFunctionArnFieldName: []interface{}{
+			map[string]interface{}{serverlessRuleArnsFieldName: []interface{}{"arn:aws:lambda:us-east-1:123456789012:function:payments"}},
+		},
+		serverlessRuleScopeFieldName: []interface{}{
+			map[string]interface{}{scopeFieldCloudAccount: "production", scopeFieldRegions: []interface{}{"us-east-1"}},
+		},
+	})
+	if rule := api.object(fakeApiServerlessRules, state.ID); updatedState.ID != state.ID || rule["name"] != "detect production functions" {
+		t.Fatalf("expected the serverless rule to be updated in place, got %s: %v", updatedState.ID, rule)
+	}
+
+	lifecycle.importState(t)
+
+	lifecycle.destroy(t)
+	if ids := api.objectIds(fakeApiServerlessRules); len(ids) != 0 {
+		t.Fatalf("expected the serverless rule to be deleted, got %v", ids)
+	}
+}
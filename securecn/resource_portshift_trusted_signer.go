@@ -2,12 +2,20 @@ package securecn
 
 import (
 	"context"
+	"fmt"
 	strfmt "github.com/go-openapi/strfmt"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
+	"net/http"
+	"sort"
+	"strings"
 	"terraform-provider-securecn/internal/client"
+	"terraform-provider-securecn/internal/escher_api/escherClient"
 	model2 "terraform-provider-securecn/internal/escher_api/model"
+	"terraform-provider-securecn/internal/keyresolver"
 	utils2 "terraform-provider-securecn/internal/utils"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -16,6 +24,17 @@ import (
 const trustedSignerNameFieldName = "name"
 const trustedSignerKeysFieldName = "keys"
 const trustedSignerClustersFieldName = "clusters"
+const trustedSignerDeprecatedKeyFieldName = "deprecated_key"
+const trustedSignerKeyNameFieldName = "name"
+const trustedSignerKeyExpiresAtFieldName = "expires_at"
+const trustedSignerKeyFingerprintsFieldName = "key_fingerprints"
+const trustedSignerActiveKeysFieldName = "active_keys"
+const trustedSignerKeySourceFieldName = "key_source"
+const trustedSignerKeyFileFieldName = "key_file"
+const trustedSignerKeyReferenceFieldName = "reference"
+
+// trustedSignerKeyResolver resolves the key sources of trusted signers into public keys
+var trustedSignerKeyResolver keyresolver.Resolver = keyresolver.NewResolver()
 
 func ResourceTrustedSigner() *schema.Resource {
 
@@ -24,28 +43,74 @@ func ResourceTrustedSigner() *schema.Resource {
 		ReadContext:   resourceTrustedSignerRead,
 		UpdateContext: resourceTrustedSignerUpdate,
 		DeleteContext: resourceTrustedSignerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: resourceTrustedSignerCustomizeDiff,
 		Description:   "A SecureCN TrustedSigner",
 		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			trustedSignerNameFieldName: {
 				Type:     schema.TypeString,
-				ForceNew: true,
 				Required: true,
 			},
 			trustedSignerKeysFieldName: {
-				Type: schema.TypeMap,
+				Description: "The PEM encoded public keys of the signer by name, e.g. as exported by cosign public-key",
+				Type:        schema.TypeMap,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
-				Required: true,
+				Optional:         true,
+				AtLeastOneOf:     []string{trustedSignerKeysFieldName, trustedSignerKeySourceFieldName},
+				ValidateDiagFunc: validateTrustedSignerKeys,
 			},
-			trustedSignerClustersFieldName: {
+			trustedSignerKeySourceFieldName: {
+				Description: "Public keys of the signer resolved locally when planning, from a file or a reference. " +
+					"A reference is exported with the cosign CLI, either cosign://<key> for any key cosign accepts, " +
+					"or a KMS reference such as awskms://<key id>",
+				Optional:     true,
+				AtLeastOneOf: []string{trustedSignerKeysFieldName, trustedSignerKeySourceFieldName},
+				Type:         schema.TypeSet,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						trustedSignerKeyNameFieldName:      {Type: schema.TypeString, Required: true, Description: "The name of the key"},
+						trustedSignerKeyFileFieldName:      {Type: schema.TypeString, Optional: true, Description: "The path of a PEM encoded public key file", ValidateFunc: validation.StringIsNotEmpty},
+						trustedSignerKeyReferenceFieldName: {Type: schema.TypeString, Optional: true, Description: "A cosign:// or KMS reference to the key, e.g. awskms:///arn:aws:kms:us-east-1:123456789012:alias/signing", ValidateFunc: validateTrustedSignerKeyReference},
+					},
+				},
+			},
+			trustedSignerDeprecatedKeyFieldName: {
+				Description: "Marks a key as deprecated while rotating keys. A deprecated key is still trusted until it expires, " +
+					"after which it is no longer sent to SecureCN",
 				Optional: true,
-				Type:     schema.TypeList,
-				MinItems: 1,
+				Type:     schema.TypeSet,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						trustedSignerKeyNameFieldName:      {Type: schema.TypeString, Required: true, Description: "The name of the key in keys or key_source"},
+						trustedSignerKeyExpiresAtFieldName: {Type: schema.TypeString, Optional: true, Description: "The RFC3339 time the key expires at, e.g. 2022-01-31T00:00:00Z", ValidateFunc: validation.IsRFC3339Time},
+					},
+				},
+			},
+			trustedSignerKeyFingerprintsFieldName: {
+				Description: "The hex encoded SHA256 fingerprint of the DER encoded public key of each key, by name",
+				Computed:    true,
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			trustedSignerActiveKeysFieldName: {
+				Description: "The names of the keys SecureCN trusts, which are all the keys except the expired ones",
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			trustedSignerClustersFieldName: {
+				Description: "The ids or names of the kubernetes clusters the signer is trusted on",
+				Optional:    true,
+				Type:        schema.TypeSet,
+				MinItems:    1,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: validation.IsUUID,
+					ValidateFunc: validation.StringIsNotEmpty,
 				},
 			},
 		},
@@ -64,19 +129,25 @@ func resourceTrustedSignerCreate(ctx context.Context, d *schema.ResourceData, m
 
 	serviceApi := utils2.GetServiceApi(&httpClientWrapper)
 
-	trustedSignerFromConfig, err := getTrustedSignerFromConfig(d)
+	clusterIdsByName, err := getTrustedSignerClusterIdsByName(ctx, serviceApi, httpClientWrapper.HttpClient, d, false)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	trustedSigner, err := serviceApi.CreateTrustedSigner(ctx, httpClientWrapper.HttpClient, trustedSignerFromConfig)
+	trustedSignerFromConfig, err := getTrustedSignerFromConfig(ctx, d, clusterIdsByName)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.SetId(string(trustedSigner.Payload.ID))
+	trustedSigner := &model2.TrustedSigner{}
+	err = serviceApi.TrustedSigners().Create(ctx, httpClientWrapper.HttpClient, trustedSignerFromConfig, trustedSigner)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	return nil
+	d.SetId(string(trustedSigner.ID))
+
+	return append(getDeprecatedTrustedSignerKeysWarnings(d), resourceTrustedSignerRead(ctx, d, m)...)
 }
 
 func resourceTrustedSignerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -87,17 +158,22 @@ func resourceTrustedSignerRead(ctx context.Context, d *schema.ResourceData, m in
 	serviceApi := utils2.GetServiceApi(&httpClientWrapper)
 	trustedSignerId := d.Id()
 
-	trustedSigner, err := serviceApi.GetTrustedSignerById(ctx, httpClientWrapper.HttpClient, strfmt.UUID(trustedSignerId))
-
-	if err != nil {
+	trustedSigner := &model2.TrustedSigner{}
+	err := serviceApi.TrustedSigners().Get(ctx, httpClientWrapper.HttpClient, strfmt.UUID(trustedSignerId), trustedSigner)
+	if err != nil && !escherClient.IsNotFound(err) {
 		return diag.FromErr(err)
 	}
 
-	if trustedSigner == nil {
+	if escherClient.IsNotFound(err) {
 		// Tell terraform the trustedSigner doesn't exist
 		d.SetId("")
 	} else {
-		return diag.FromErr(updateTrustedSignerMutableFields(d, trustedSigner.GetPayload()))
+		clusterIdsByName, err := getTrustedSignerClusterIdsByName(ctx, serviceApi, httpClientWrapper.HttpClient, d, true)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		return diag.FromErr(updateTrustedSignerMutableFields(d, trustedSigner, clusterIdsByName))
 	}
 
 	return nil
@@ -115,17 +191,22 @@ func resourceTrustedSignerUpdate(ctx context.Context, d *schema.ResourceData, m
 
 	serviceApi := utils2.GetServiceApi(&httpClientWrapper)
 
-	trustedSigner, err := getTrustedSignerFromConfig(d)
+	clusterIdsByName, err := getTrustedSignerClusterIdsByName(ctx, serviceApi, httpClientWrapper.HttpClient, d, false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	trustedSigner, err := getTrustedSignerFromConfig(ctx, d, clusterIdsByName)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	_, err = serviceApi.UpdateTrustedSigner(ctx, httpClientWrapper.HttpClient, trustedSigner, trustedSigner.ID)
+	err = serviceApi.TrustedSigners().Update(ctx, httpClientWrapper.HttpClient, strfmt.UUID(d.Id()), trustedSigner, nil)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	return resourceTrustedSignerRead(ctx, d, m)
+	return append(getDeprecatedTrustedSignerKeysWarnings(d), resourceTrustedSignerRead(ctx, d, m)...)
 }
 
 func resourceTrustedSignerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -134,7 +215,7 @@ func resourceTrustedSignerDelete(ctx context.Context, d *schema.ResourceData, m
 	httpClientWrapper := m.(client.HttpClientWrapper)
 
 	serviceApi := utils2.GetServiceApi(&httpClientWrapper)
-	_, err := serviceApi.DeleteTrustedSigner(ctx, httpClientWrapper.HttpClient, strfmt.UUID(d.Id()))
+	err := serviceApi.TrustedSigners().Delete(ctx, httpClientWrapper.HttpClient, strfmt.UUID(d.Id()))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -148,15 +229,244 @@ func resourceTrustedSignerDelete(ctx context.Context, d *schema.ResourceData, m
 func validateTrustedSignerConfig(d *schema.ResourceData) error {
 	log.Printf("[DEBUG] validating trustedSigner config")
 
-	return nil
+	keys := d.Get(trustedSignerKeysFieldName).(map[string]interface{})
+	keySources, err := getTrustedSignerKeySourcesFromConfig(d.Get(trustedSignerKeySourceFieldName).(*schema.Set))
+	if err != nil {
+		return err
+	}
+	deprecatedKeys := getDeprecatedTrustedSignerKeysFromConfig(d.Get(trustedSignerDeprecatedKeyFieldName).(*schema.Set))
+
+	keyNames, err := getTrustedSignerKeyNames(keys, keySources)
+	if err != nil {
+		return err
+	}
+
+	_, err = getActiveTrustedSignerKeyNames(keyNames, deprecatedKeys, time.Now())
+	return err
+}
+
+func resourceTrustedSignerCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown(trustedSignerKeysFieldName) || !d.NewValueKnown(trustedSignerKeySourceFieldName) || !d.NewValueKnown(trustedSignerDeprecatedKeyFieldName) {
+		_ = d.SetNewComputed(trustedSignerKeyFingerprintsFieldName)
+		return d.SetNewComputed(trustedSignerActiveKeysFieldName)
+	}
+
+	keys := d.Get(trustedSignerKeysFieldName).(map[string]interface{})
+	keySources, err := getTrustedSignerKeySourcesFromConfig(d.Get(trustedSignerKeySourceFieldName).(*schema.Set))
+	if err != nil {
+		return err
+	}
+	deprecatedKeys := getDeprecatedTrustedSignerKeysFromConfig(d.Get(trustedSignerDeprecatedKeyFieldName).(*schema.Set))
+
+	keyNames, err := getTrustedSignerKeyNames(keys, keySources)
+	if err != nil {
+		return err
+	}
+
+	activeKeyNames, err := getActiveTrustedSignerKeyNames(keyNames, deprecatedKeys, time.Now())
+	if err != nil {
+		return err
+	}
+
+	// the key sources are resolved on every plan, so a key that changed in its file or KMS shows up as a change of its fingerprint
+	resolvedKeys, err := resolveTrustedSignerKeySources(ctx, keySources)
+	if err != nil {
+		return err
+	}
+	for name, key := range keys {
+		resolvedKeys[name] = key
+	}
+
+	err = d.SetNew(trustedSignerKeyFingerprintsFieldName, getTrustedSignerKeyFingerprints(resolvedKeys))
+	if err != nil {
+		return err
+	}
+
+	// a key that expired since the last apply shows up as a change of the active keys
+	return d.SetNew(trustedSignerActiveKeysFieldName, activeKeyNames)
+}
+
+func validateTrustedSignerKeys(i interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for name, key := range i.(map[string]interface{}) {
+		if _, _, err := utils2.DecodePublicKey(key.(string)); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("invalid public key %s", name),
+				Detail:        err.Error(),
+				AttributePath: path.IndexString(name),
+			})
+		}
+	}
+
+	return diags
+}
+
+func validateTrustedSignerKeyReference(i interface{}, k string) ([]string, []error) {
+	reference, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	if !strings.Contains(reference, "://") || strings.HasPrefix(reference, keyresolver.FileScheme+"://") {
+		return nil, []error{fmt.Errorf("expected %s to be a cosign:// or KMS reference, use key_file for key files, got %s", k, reference)}
+	}
+	if err := keyresolver.NewResolver().Validate(reference); err != nil {
+		return nil, []error{fmt.Errorf("invalid %s: %v", k, err)}
+	}
+
+	return nil, nil
+}
+
+// getTrustedSignerKeySourcesFromConfig returns the reference of each key source by name, key files are referenced with the file scheme
+func getTrustedSignerKeySourcesFromConfig(keySourcesInConfig *schema.Set) (map[string]string, error) {
+	keySources := make(map[string]string, keySourcesInConfig.Len())
+	for _, keySource := range keySourcesInConfig.List() {
+		keySourceMap := keySource.(map[string]interface{})
+		name := keySourceMap[trustedSignerKeyNameFieldName].(string)
+		keyFile := keySourceMap[trustedSignerKeyFileFieldName].(string)
+		reference := keySourceMap[trustedSignerKeyReferenceFieldName].(string)
+
+		if (keyFile == "") == (reference == "") {
+			return nil, fmt.Errorf("key source %s must have exactly one of %s or %s", name, trustedSignerKeyFileFieldName, trustedSignerKeyReferenceFieldName)
+		}
+		if _, ok := keySources[name]; ok {
+			return nil, fmt.Errorf("duplicate key source %s", name)
+		}
+
+		if keyFile != "" {
+			reference = keyresolver.FileScheme + "://" + keyFile
+		}
+		keySources[name] = reference
+	}
+
+	return keySources, nil
 }
 
-func getTrustedSignerFromConfig(d *schema.ResourceData) (*model2.TrustedSigner, error) {
+// resolveTrustedSignerKeySources returns the PEM encoded public key of each key source by name
+func resolveTrustedSignerKeySources(ctx context.Context, keySources map[string]string) (map[string]interface{}, error) {
+	resolvedKeys := make(map[string]interface{}, len(keySources))
+	for name, reference := range keySources {
+		key, err := trustedSignerKeyResolver.Resolve(ctx, reference)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve key source %s: %v", name, err)
+		}
+		if _, _, err := utils2.DecodePublicKey(key); err != nil {
+			return nil, fmt.Errorf("invalid public key of key source %s: %v", name, err)
+		}
+		resolvedKeys[name] = key
+	}
+
+	return resolvedKeys, nil
+}
+
+func getTrustedSignerKeyNames(keys map[string]interface{}, keySources map[string]string) ([]string, error) {
+	keyNames := make([]string, 0, len(keys)+len(keySources))
+	for name := range keys {
+		keyNames = append(keyNames, name)
+	}
+	for name := range keySources {
+		if _, ok := keys[name]; ok {
+			return nil, fmt.Errorf("key %s is defined both in %s and in %s", name, trustedSignerKeysFieldName, trustedSignerKeySourceFieldName)
+		}
+		keyNames = append(keyNames, name)
+	}
+
+	return keyNames, nil
+}
+
+// getDeprecatedTrustedSignerKeysFromConfig returns the expiration time of each deprecated key by name, zero for no expiration
+func getDeprecatedTrustedSignerKeysFromConfig(deprecatedKeysInConfig *schema.Set) map[string]time.Time {
+	deprecatedKeys := make(map[string]time.Time, deprecatedKeysInConfig.Len())
+	for _, deprecatedKey := range deprecatedKeysInConfig.List() {
+		deprecatedKeyMap := deprecatedKey.(map[string]interface{})
+		// validated as RFC3339 by the schema
+		expiresAt, _ := time.Parse(time.RFC3339, deprecatedKeyMap[trustedSignerKeyExpiresAtFieldName].(string))
+		deprecatedKeys[deprecatedKeyMap[trustedSignerKeyNameFieldName].(string)] = expiresAt
+	}
+
+	return deprecatedKeys
+}
+
+// getActiveTrustedSignerKeyNames returns the sorted names of the keys that didn't expire at the given time
+func getActiveTrustedSignerKeyNames(keyNames []string, deprecatedKeys map[string]time.Time, now time.Time) ([]string, error) {
+	for name := range deprecatedKeys {
+		if !utils2.ContainsString(keyNames, name) {
+			return nil, fmt.Errorf("deprecated key %s is not one of the keys of the trusted signer", name)
+		}
+	}
+
+	activeKeyNames := make([]string, 0, len(keyNames))
+	for _, name := range keyNames {
+		if !isTrustedSignerKeyExpired(name, deprecatedKeys, now) {
+			activeKeyNames = append(activeKeyNames, name)
+		}
+	}
+	sort.Strings(activeKeyNames)
+
+	if len(keyNames) != 0 && len(activeKeyNames) == 0 {
+		return nil, fmt.Errorf("all the keys of the trusted signer expired, at least one key must be active")
+	}
+
+	return activeKeyNames, nil
+}
+
+func isTrustedSignerKeyExpired(name string, deprecatedKeys map[string]time.Time, now time.Time) bool {
+	expiresAt, ok := deprecatedKeys[name]
+	return ok && !expiresAt.IsZero() && !now.Before(expiresAt)
+}
+
+func getTrustedSignerKeyFingerprints(keys map[string]interface{}) map[string]string {
+	fingerprints := make(map[string]string, len(keys))
+	for name, key := range keys {
+		fingerprint, err := utils2.GetPublicKeyFingerprint(key.(string))
+		if err != nil {
+			log.Printf("[WARN] failed to get the fingerprint of key %s: %v", name, err)
+			continue
+		}
+		fingerprints[name] = fingerprint
+	}
+
+	return fingerprints
+}
+
+func getDeprecatedTrustedSignerKeysWarnings(d *schema.ResourceData) diag.Diagnostics {
+	deprecatedKeys := getDeprecatedTrustedSignerKeysFromConfig(d.Get(trustedSignerDeprecatedKeyFieldName).(*schema.Set))
+
+	names := make([]string, 0, len(deprecatedKeys))
+	for name := range deprecatedKeys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var diags diag.Diagnostics
+	for _, name := range names {
+		expiresAt := deprecatedKeys[name]
+		detail := fmt.Sprintf("key %s of trusted signer %s is deprecated", name, d.Get(trustedSignerNameFieldName).(string))
+		if isTrustedSignerKeyExpired(name, deprecatedKeys, time.Now()) {
+			detail += fmt.Sprintf(" and expired at %s, it is no longer trusted", expiresAt.Format(time.RFC3339))
+		} else if !expiresAt.IsZero() {
+			detail += fmt.Sprintf(" and expires at %s", expiresAt.Format(time.RFC3339))
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("deprecated trusted signer key %s", name),
+			Detail:   detail,
+		})
+	}
+
+	return diags
+}
+
+func getTrustedSignerFromConfig(ctx context.Context, d *schema.ResourceData, clusterIdsByName map[string]strfmt.UUID) (*model2.TrustedSigner, error) {
 	log.Print("[DEBUG] getting trustedSigner from config")
 
 	name := d.Get(trustedSignerNameFieldName).(string)
-	trustedSignerKeys := getTrustedSignerKeysFromConfig(d)
-	trustedSignerClusters := getTrustedSignerClustersKeysFromConfig(d)
+	trustedSignerKeys, err := getTrustedSignerKeysFromConfig(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	trustedSignerClusters := getTrustedSignerClustersKeysFromConfig(d, clusterIdsByName)
 
 	trustedSigner := &model2.TrustedSigner{
 		Name:                  &name,
@@ -167,12 +477,24 @@ func getTrustedSignerFromConfig(d *schema.ResourceData) (*model2.TrustedSigner,
 	return trustedSigner, nil
 }
 
-func getTrustedSignerClustersKeysFromConfig(d *schema.ResourceData) []*model2.TrustedSignerCluster {
-	clustersInConfig := d.Get(trustedSignerClustersFieldName).([]interface{})
+func getTrustedSignerClustersKeysFromConfig(d *schema.ResourceData, clusterIdsByName map[string]strfmt.UUID) []*model2.TrustedSignerCluster {
+	clustersInConfig := d.Get(trustedSignerClustersFieldName).(*schema.Set).List()
 	clusters := make([]*model2.TrustedSignerCluster, 0, len(clustersInConfig))
-	for _, clusterId := range clustersInConfig {
+	clusterIds := map[strfmt.UUID]bool{}
+	for _, clusterInConfig := range clustersInConfig {
+		clusterId := strfmt.UUID(clusterInConfig.(string))
+		if clusterIdByName, ok := clusterIdsByName[clusterInConfig.(string)]; ok {
+			clusterId = clusterIdByName
+		}
+
+		// a cluster can be configured both by id and by name
+		if clusterIds[clusterId] {
+			continue
+		}
+		clusterIds[clusterId] = true
+
 		cluster := &model2.TrustedSignerCluster{
-			ID: strfmt.UUID(clusterId.(string)),
+			ID: clusterId,
 		}
 		clusters = append(clusters, cluster)
 	}
@@ -180,10 +502,51 @@ func getTrustedSignerClustersKeysFromConfig(d *schema.ResourceData) []*model2.Tr
 	return clusters
 }
 
-func getTrustedSignerKeysFromConfig(d *schema.ResourceData) []*model2.TrustedSignerKey {
-	keysMap := d.Get(trustedSignerKeysFieldName).(map[string]interface{})
+// getTrustedSignerClusterIdsByName resolves the clusters configured by name into their ids,
+// a cluster that doesn't exist anymore is skipped when ignoreMissing is set, so reading the signer shows it as removed
+func getTrustedSignerClusterIdsByName(ctx context.Context, serviceApi *escherClient.MgmtServiceApiCtx, httpClient *http.Client, d *schema.ResourceData, ignoreMissing bool) (map[string]strfmt.UUID, error) {
+	clusterIdsByName := map[string]strfmt.UUID{}
+	for _, clusterInConfig := range d.Get(trustedSignerClustersFieldName).(*schema.Set).List() {
+		clusterName := clusterInConfig.(string)
+		if strfmt.IsUUID(clusterName) {
+			continue
+		}
+
+		clusterId, err := serviceApi.GetKubernetesClusterIdByName(ctx, httpClient, clusterName)
+		if err != nil {
+			if ignoreMissing {
+				log.Printf("[WARN] failed to get the id of trusted signer cluster %s: %v", clusterName, err)
+				continue
+			}
+			return nil, err
+		}
+		clusterIdsByName[clusterName] = clusterId
+	}
+
+	return clusterIdsByName, nil
+}
+
+func getTrustedSignerKeysFromConfig(ctx context.Context, d *schema.ResourceData) ([]*model2.TrustedSignerKey, error) {
+	keySources, err := getTrustedSignerKeySourcesFromConfig(d.Get(trustedSignerKeySourceFieldName).(*schema.Set))
+	if err != nil {
+		return nil, err
+	}
+	keysMap, err := resolveTrustedSignerKeySources(ctx, keySources)
+	if err != nil {
+		return nil, err
+	}
+	for name, key := range d.Get(trustedSignerKeysFieldName).(map[string]interface{}) {
+		keysMap[name] = key
+	}
+
+	deprecatedKeys := getDeprecatedTrustedSignerKeysFromConfig(d.Get(trustedSignerDeprecatedKeyFieldName).(*schema.Set))
+	now := time.Now()
 	keys := make([]*model2.TrustedSignerKey, 0, len(keysMap))
 	for k, v := range keysMap {
+		k := k
+		if isTrustedSignerKeyExpired(k, deprecatedKeys, now) {
+			continue
+		}
 		value := v.(string)
 		key := &model2.TrustedSignerKey{
 			Key:  &value,
@@ -192,7 +555,7 @@ func getTrustedSignerKeysFromConfig(d *schema.ResourceData) []*model2.TrustedSig
 		keys = append(keys, key)
 	}
 
-	return filterEmptyTrustedSignerKeys(keys)
+	return filterEmptyTrustedSignerKeys(keys), nil
 }
 
 func filterEmptyTrustedSignerKeys(labels []*model2.TrustedSignerKey) []*model2.TrustedSignerKey {
@@ -205,7 +568,7 @@ func filterEmptyTrustedSignerKeys(labels []*model2.TrustedSignerKey) []*model2.T
 	return ans
 }
 
-func updateTrustedSignerMutableFields(d *schema.ResourceData, currentTrustedSignerInSecureCn *model2.TrustedSigner) error {
+func updateTrustedSignerMutableFields(d *schema.ResourceData, currentTrustedSignerInSecureCn *model2.TrustedSigner, clusterIdsByName map[string]strfmt.UUID) error {
 	err := d.Set(trustedSignerNameFieldName, currentTrustedSignerInSecureCn.Name)
 	if err != nil {
 		return err
@@ -216,7 +579,7 @@ func updateTrustedSignerMutableFields(d *schema.ResourceData, currentTrustedSign
 		return err
 	}
 
-	err = updateTrustedSignerMutableFieldsClusters(d, currentTrustedSignerInSecureCn)
+	err = updateTrustedSignerMutableFieldsClusters(d, currentTrustedSignerInSecureCn, clusterIdsByName)
 	if err != nil {
 		return err
 	}
@@ -224,26 +587,85 @@ func updateTrustedSignerMutableFields(d *schema.ResourceData, currentTrustedSign
 }
 
 func updateTrustedSignerMutableFieldsKeys(d *schema.ResourceData, currentSignerInSecureCN *model2.TrustedSigner) error {
+	// the keys of key sources are resolved from the configuration, so they are read back only as fingerprints
+	keySourceNames := map[string]bool{}
+	for _, keySource := range d.Get(trustedSignerKeySourceFieldName).(*schema.Set).List() {
+		keySourceNames[keySource.(map[string]interface{})[trustedSignerKeyNameFieldName].(string)] = true
+	}
+
 	keysInSecureCN := currentSignerInSecureCN.Keys
-	signerKeys := make(map[string]string, len(keysInSecureCN))
+	signerKeys := make(map[string]interface{}, len(keysInSecureCN))
+	fingerprintedKeys := make(map[string]interface{}, len(keysInSecureCN))
+	activeKeyNames := make([]string, 0, len(keysInSecureCN))
 	for _, singleKeyInSecureCN := range keysInSecureCN {
 		nameInSecureCn := singleKeyInSecureCN.Name
 		keyInSecureCn := singleKeyInSecureCN.Key
-		signerKeys[*nameInSecureCn] = *keyInSecureCn
+		if !keySourceNames[*nameInSecureCn] {
+			signerKeys[*nameInSecureCn] = *keyInSecureCn
+		}
+		fingerprintedKeys[*nameInSecureCn] = *keyInSecureCn
+		activeKeyNames = append(activeKeyNames, *nameInSecureCn)
+	}
+	sort.Strings(activeKeyNames)
+
+	// the expired keys are kept in the state, as they are left out of SecureCN on purpose
+	deprecatedKeys := getDeprecatedTrustedSignerKeysFromConfig(d.Get(trustedSignerDeprecatedKeyFieldName).(*schema.Set))
+	now := time.Now()
+	for name, key := range d.Get(trustedSignerKeysFieldName).(map[string]interface{}) {
+		if _, ok := fingerprintedKeys[name]; !ok && isTrustedSignerKeyExpired(name, deprecatedKeys, now) {
+			signerKeys[name] = key
+			fingerprintedKeys[name] = key
+		}
+	}
+
+	fingerprints := getTrustedSignerKeyFingerprints(fingerprintedKeys)
+	for name, fingerprint := range d.Get(trustedSignerKeyFingerprintsFieldName).(map[string]interface{}) {
+		if _, ok := fingerprintedKeys[name]; !ok && keySourceNames[name] && isTrustedSignerKeyExpired(name, deprecatedKeys, now) {
+			fingerprints[name] = fingerprint.(string)
+		}
 	}
 
 	err := d.Set(trustedSignerKeysFieldName, signerKeys)
 	if err != nil {
 		return err
 	}
+	err = d.Set(trustedSignerKeyFingerprintsFieldName, fingerprints)
+	if err != nil {
+		return err
+	}
+	err = d.Set(trustedSignerActiveKeysFieldName, activeKeyNames)
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
-func updateTrustedSignerMutableFieldsClusters(d *schema.ResourceData, currentRuleInSecureCN *model2.TrustedSigner) error {
-	clustersInSecureCN := currentRuleInSecureCN.TrustedSignerClusters
-	signerClusters := make([]string, 0, len(clustersInSecureCN))
-	for _, singleKeyInSecureCN := range clustersInSecureCN {
-		signerClusters = append(signerClusters, singleKeyInSecureCN.ID.String())
+// updateTrustedSignerMutableFieldsClusters reads back the clusters the way they are configured, by id or by name,
+// clusters that were added outside of terraform are read back by id
+func updateTrustedSignerMutableFieldsClusters(d *schema.ResourceData, currentRuleInSecureCN *model2.TrustedSigner, clusterIdsByName map[string]strfmt.UUID) error {
+	clusterIdsInSecureCN := map[strfmt.UUID]bool{}
+	for _, singleClusterInSecureCN := range currentRuleInSecureCN.TrustedSignerClusters {
+		clusterIdsInSecureCN[singleClusterInSecureCN.ID] = true
+	}
+
+	signerClusters := make([]interface{}, 0, len(clusterIdsInSecureCN))
+	configuredClusterIds := map[strfmt.UUID]bool{}
+	for _, clusterInConfig := range d.Get(trustedSignerClustersFieldName).(*schema.Set).List() {
+		clusterId := strfmt.UUID(clusterInConfig.(string))
+		if clusterIdByName, ok := clusterIdsByName[clusterInConfig.(string)]; ok {
+			clusterId = clusterIdByName
+		}
+
+		if clusterIdsInSecureCN[clusterId] {
+			signerClusters = append(signerClusters, clusterInConfig)
+			configuredClusterIds[clusterId] = true
+		}
+	}
+
+	for clusterId := range clusterIdsInSecureCN {
+		if !configuredClusterIds[clusterId] {
+			signerClusters = append(signerClusters, clusterId.String())
+		}
 	}
 
 	err := d.Set(trustedSignerClustersFieldName, signerClusters)